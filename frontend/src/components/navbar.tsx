@@ -2,7 +2,7 @@ import { Component, Show } from 'solid-js'
 import DarkModeToggle from './dark-mode-toggle'
 import { Popover, PopoverContent, PopoverTrigger } from './ui/popover'
 import { Button } from './ui/button'
-import { FiLogOut, FiRss, FiUser } from 'solid-icons/fi'
+import { FiDownload, FiLogOut, FiRss, FiTrash2, FiUser } from 'solid-icons/fi'
 import {
 	DropdownMenu,
 	DropdownMenuContent,
@@ -32,6 +32,27 @@ const Navbar: Component = () => {
 		navigate('/')
 	}
 
+	const exportMyData = async () => {
+		const response = await connect.exportMyData({})
+		const url = URL.createObjectURL(new Blob([response.archive], { type: 'application/json' }))
+
+		const link = document.createElement('a')
+		link.href = url
+		link.download = response.fileName
+		link.click()
+		URL.revokeObjectURL(url)
+	}
+
+	const deleteMyAccount = async () => {
+		if (!window.confirm('This deletes your account and all of your data for good. Do you want to continue?')) {
+			return
+		}
+
+		await connect.deleteMyAccount({})
+		logoutAndRemoveAuthData()
+		navigate('/')
+	}
+
 	return (
 		<div class="flex flex-col md:flex-row items-center justify-between space-y-2">
 			<div class="flex flex-col w-full justify-start">
@@ -64,10 +85,19 @@ const Navbar: Component = () => {
 							{state.user?.name}
 						</DropdownMenuLabel>
 						<DropdownMenuSeparator />
+						<DropdownMenuItem class="cursor-pointer" onClick={exportMyData}>
+							<FiDownload class="w-4 h-4" />
+							Export my data
+						</DropdownMenuItem>
 						<DropdownMenuItem class="cursor-pointer" onClick={logout}>
 							<FiLogOut class="w-4 h-4" />
 							Logout
 						</DropdownMenuItem>
+						<DropdownMenuSeparator />
+						<DropdownMenuItem class="cursor-pointer text-destructive" onClick={deleteMyAccount}>
+							<FiTrash2 class="w-4 h-4" />
+							Delete account
+						</DropdownMenuItem>
 					</DropdownMenuContent>
 				</DropdownMenu>
 			</div>
@@ -10,8 +10,9 @@ const abortController = new AbortController()
 const authInterceptor: Interceptor = next => async req => {
 	if (!(await isAuthenticated())) {
 		abortController.abort()
-		// We don't always have access to solid router, so we'll just redirect to login
-		window.location.href = '/login'
+		// We don't always have access to solid router, so we'll just redirect to login and come back afterwards
+		const returnTo = window.location.pathname + window.location.search
+		window.location.href = `/login?return_to=${encodeURIComponent(returnTo)}`
 		return await next(req)
 	}
 
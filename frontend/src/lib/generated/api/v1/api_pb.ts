@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/api.proto.
  */
 export const file_api_v1_api: GenFile = /*@__PURE__*/
-  fileDesc("ChBhcGkvdjEvYXBpLnByb3RvEgZhcGkudjEiTQoHUmVsZWFzZRIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg8KB3ZlcnNpb24YAyABKAkSDgoGYXV0aG9yGAQgASgJIk8KClJlcG9zaXRvcnkSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRILCgN1cmwYAyABKAkSEQoJaW1hZ2VfdXJsGAQgASgJIr8CCg1UaW1lbGluZUVudHJ5EgoKAmlkGAEgASgFEhUKDXJlcG9zaXRvcnlfaWQYAiABKAUSDAoEbmFtZRgDIAEoCRILCgN1cmwYBCABKAkSEAoIdGFnX25hbWUYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSFQoNaXNfcHJlcmVsZWFzZRgHIAEoCBIvCgtyZWxlYXNlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPcmVwb3NpdG9yeV9uYW1lGAkgASgJEhEKCWltYWdlX3VybBgKIAEoCRIOCgZhdXRob3IYCyABKAkSFgoOcmVwb3NpdG9yeV91cmwYDCABKAkSLQoJc3Rhcl90eXBlGA0gASgOMhouYXBpLnYxLlJlcG9zaXRvcnlTdGFyVHlwZSIfCgtTeW5jUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSJQCgxTeW5jUmVzcG9uc2USJwoIdGltZWxpbmUYASADKAsyFS5hcGkudjEuVGltZWxpbmVFbnRyeRIXCg9yZXBvc2l0b3J5Q291bnQYAiABKAUibgoWR2V0UmVwb3NpdG9yaWVzUmVxdWVzdBISCgpwcmVyZWxlYXNlGAEgASgIEjIKCXN0YXJfdHlwZRgCIAEoDjIaLmFwaS52MS5SZXBvc2l0b3J5U3RhclR5cGVIAIgBAUIMCgpfc3Rhcl90eXBlIkIKF0dldFJlcG9zaXRvcmllc1Jlc3BvbnNlEicKCHRpbWVsaW5lGAEgAygLMhUuYXBpLnYxLlRpbWVsaW5lRW50cnkiLgobVG9vZ2xlVXNlclB1YmxpY0ZlZWRSZXF1ZXN0Eg8KB2VuYWJsZWQYASABKAgiMQocVG9vZ2xlVXNlclB1YmxpY0ZlZWRSZXNwb25zZRIRCglwdWJsaWNfaWQYASABKAkiEgoQR2V0TXlVc2VyUmVxdWVzdCKdAQoRR2V0TXlVc2VyUmVzcG9uc2USCgoCaWQYASABKAUSMgoObGFzdF9zeW5jZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCWlzX3B1YmxpYxgDIAEoCBIRCglwdWJsaWNfaWQYBCABKAkSDAoEbmFtZRgFIAEoCRIUCgxpc19vbmJvYXJkZWQYBiABKAgiDwoNTG9nb3V0UmVxdWVzdCIQCg5Mb2dvdXRSZXNwb25zZSIcChpUb2dnbGVVc2VyT25ib2FyZGVkUmVxdWVzdCIdChtUb2dnbGVVc2VyT25ib2FyZGVkUmVzcG9uc2UiFQoTUmVmcmVzaFRva2VuUmVxdWVzdCK+AQoUUmVmcmVzaFRva2VuUmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJEhUKDXJlZnJlc2hfdG9rZW4YAiABKAkSOwoXYWNjZXNzX3Rva2VuX2V4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjwKGHJlZnJlc2hfdG9rZW5fZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAqKQoSUmVwb3NpdG9yeVN0YXJUeXBlEggKBFNUQVIQABIJCgVXQVRDSBABMtEDCgpBcGlTZXJ2aWNlEjEKBFN5bmMSEy5hcGkudjEuU3luY1JlcXVlc3QaFC5hcGkudjEuU3luY1Jlc3BvbnNlElIKD0dldFJlcG9zaXRvcmllcxIeLmFwaS52MS5HZXRSZXBvc2l0b3JpZXNSZXF1ZXN0Gh8uYXBpLnYxLkdldFJlcG9zaXRvcmllc1Jlc3BvbnNlEmEKFFRvb2dsZVVzZXJQdWJsaWNGZWVkEiMuYXBpLnYxLlRvb2dsZVVzZXJQdWJsaWNGZWVkUmVxdWVzdBokLmFwaS52MS5Ub29nbGVVc2VyUHVibGljRmVlZFJlc3BvbnNlEkAKCUdldE15VXNlchIYLmFwaS52MS5HZXRNeVVzZXJSZXF1ZXN0GhkuYXBpLnYxLkdldE15VXNlclJlc3BvbnNlEjcKBkxvZ291dBIVLmFwaS52MS5Mb2dvdXRSZXF1ZXN0GhYuYXBpLnYxLkxvZ291dFJlc3BvbnNlEl4KE1RvZ2dsZVVzZXJPbmJvYXJkZWQSIi5hcGkudjEuVG9nZ2xlVXNlck9uYm9hcmRlZFJlcXVlc3QaIy5hcGkudjEuVG9nZ2xlVXNlck9uYm9hcmRlZFJlc3BvbnNlMlgKC0F1dGhTZXJ2aWNlEkkKDFJlZnJlc2hUb2tlbhIbLmFwaS52MS5SZWZyZXNoVG9rZW5SZXF1ZXN0GhwuYXBpLnYxLlJlZnJlc2hUb2tlblJlc3BvbnNlQj1aO2dpdGh1Yi5jb20vYmVuamFzcGVyL3JlbGVhc2VzLm9uZS9pbnRlcm5hbC9nZW4vYXBpL3YxO2FwaXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChBhcGkvdjEvYXBpLnByb3RvEgZhcGkudjEiTQoHUmVsZWFzZRIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg8KB3ZlcnNpb24YAyABKAkSDgoGYXV0aG9yGAQgASgJItoBCgpSZXBvc2l0b3J5EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSCwoDdXJsGAMgASgJEhEKCWltYWdlX3VybBgEIAEoCRIKCgJpZBgFIAEoBRIQCghpc19tdXRlZBgGIAEoCBIxCg1zbm9vemVkX3VudGlsGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIgChNzbm9vemVkX3VudGlsX21ham9yGAggASgFSACIAQFCFgoUX3Nub296ZWRfdW50aWxfbWFqb3IimgUKDVRpbWVsaW5lRW50cnkSCgoCaWQYASABKAUSFQoNcmVwb3NpdG9yeV9pZBgCIAEoBRIMCgRuYW1lGAMgASgJEgsKA3VybBgEIAEoCRIQCgh0YWdfbmFtZRgFIAEoCRITCgtkZXNjcmlwdGlvbhgGIAEoCRIVCg1pc19wcmVyZWxlYXNlGAcgASgIEi8KC3JlbGVhc2VkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCg9yZXBvc2l0b3J5X25hbWUYCSABKAkSEQoJaW1hZ2VfdXJsGAogASgJEg4KBmF1dGhvchgLIAEoCRIWCg5yZXBvc2l0b3J5X3VybBgMIAEoCRItCglzdGFyX3R5cGUYDSABKA4yGi5hcGkudjEuUmVwb3NpdG9yeVN0YXJUeXBlEjEKDWZpcnN0X3NlZW5fYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhMKC2lzX2JhY2tmaWxsGA8gASgIEg8KB2lzX3JlYWQYECABKAgSEwoLaXNfc2VjdXJpdHkYESABKAgSEwoLaXNfYnJlYWtpbmcYEiABKAgSFwoPaGFzX2RlcHJlY2F0aW9uGBMgASgIEhsKE2hhc19taWdyYXRpb25fZ3VpZGUYFCABKAgSGQoRdnVsbmVyYWJpbGl0eV9pZHMYFSADKAkSJAoKYWR2aXNvcmllcxgWIAMoCzIQLmFwaS52MS5BZHZpc29yeRItCgllZGl0ZWRfYXQYFyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDHJldHJhY3RlZF9hdBgYIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijQEKCEFkdmlzb3J5Eg8KB2doc2FfaWQYASABKAkSDgoGY3ZlX2lkGAIgASgJEhAKCHNldmVyaXR5GAMgASgJEg8KB3N1bW1hcnkYBCABKAkSCwoDdXJsGAUgASgJEjAKDHB1Ymxpc2hlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiHwoLU3luY1JlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiUAoMU3luY1Jlc3BvbnNlEicKCHRpbWVsaW5lGAEgAygLMhUuYXBpLnYxLlRpbWVsaW5lRW50cnkSFwoPcmVwb3NpdG9yeUNvdW50GAIgASgFItMCChZHZXRSZXBvc2l0b3JpZXNSZXF1ZXN0EhIKCnByZXJlbGVhc2UYASABKAgSMgoJc3Rhcl90eXBlGAIgASgOMhouYXBpLnYxLlJlcG9zaXRvcnlTdGFyVHlwZUgAiAEBEjIKCW5ld19zaW5jZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARITCgt1bnJlYWRfb25seRgEIAEoCBIVCghncm91cF9pZBgFIAEoBUgCiAEBEhQKB2xpc3RfaWQYBiABKAVIA4gBARIVCghzZWN1cml0eRgHIAEoCEgEiAEBEhUKCGJyZWFraW5nGAggASgISAWIAQFCDAoKX3N0YXJfdHlwZUIMCgpfbmV3X3NpbmNlQgsKCV9ncm91cF9pZEIKCghfbGlzdF9pZEILCglfc2VjdXJpdHlCCwoJX2JyZWFraW5nIlgKF0dldFJlcG9zaXRvcmllc1Jlc3BvbnNlEicKCHRpbWVsaW5lGAEgAygLMhUuYXBpLnYxLlRpbWVsaW5lRW50cnkSFAoMdW5yZWFkX2NvdW50GAIgASgFIi4KG1Rvb2dsZVVzZXJQdWJsaWNGZWVkUmVxdWVzdBIPCgdlbmFibGVkGAEgASgIIjEKHFRvb2dsZVVzZXJQdWJsaWNGZWVkUmVzcG9uc2USEQoJcHVibGljX2lkGAEgASgJIhIKEEdldE15VXNlclJlcXVlc3QitAEKEUdldE15VXNlclJlc3BvbnNlEgoKAmlkGAEgASgFEjIKDmxhc3Rfc3luY2VkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCglpc19wdWJsaWMYAyABKAgSEQoJcHVibGljX2lkGAQgASgJEgwKBG5hbWUYBSABKAkSFAoMaXNfb25ib2FyZGVkGAYgASgIEhUKDWdpdGh1Yl9saW5rZWQYByABKAgiDwoNTG9nb3V0UmVxdWVzdCIQCg5Mb2dvdXRSZXNwb25zZSIcChpUb2dnbGVVc2VyT25ib2FyZGVkUmVxdWVzdCIdChtUb2dnbGVVc2VyT25ib2FyZGVkUmVzcG9uc2UiLAoWTWFya1JlbGVhc2VSZWFkUmVxdWVzdBISCgpyZWxlYXNlX2lkGAEgASgFIhkKF01hcmtSZWxlYXNlUmVhZFJlc3BvbnNlIjIKGU1hcmtSZXBvc2l0b3J5UmVhZFJlcXVlc3QSFQoNcmVwb3NpdG9yeV9pZBgBIAEoBSIcChpNYXJrUmVwb3NpdG9yeVJlYWRSZXNwb25zZSIUChJNYXJrQWxsUmVhZFJlcXVlc3QiFQoTTWFya0FsbFJlYWRSZXNwb25zZSKgAQoIQm9va21hcmsSJgoHcmVsZWFzZRgBIAEoCzIVLmFwaS52MS5UaW1lbGluZUVudHJ5EgwKBG5vdGUYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiFQoTR2V0Qm9va21hcmtzUmVxdWVzdCJUChRHZXRCb29rbWFya3NSZXNwb25zZRIjCglib29rbWFya3MYASADKAsyEC5hcGkudjEuQm9va21hcmsSFwoPcHJpdmF0ZV9mZWVkX2lkGAIgASgJIjYKEkFkZEJvb2ttYXJrUmVxdWVzdBISCgpyZWxlYXNlX2lkGAEgASgFEgwKBG5vdGUYAiABKAkiFQoTQWRkQm9va21hcmtSZXNwb25zZSIrChVSZW1vdmVCb29rbWFya1JlcXVlc3QSEgoKcmVsZWFzZV9pZBgBIAEoBSIYChZSZW1vdmVCb29rbWFya1Jlc3BvbnNlIj0KFU11dGVSZXBvc2l0b3J5UmVxdWVzdBIVCg1yZXBvc2l0b3J5X2lkGAEgASgFEg0KBW11dGVkGAIgASgIIhgKFk11dGVSZXBvc2l0b3J5UmVzcG9uc2UihAEKF1Nub296ZVJlcG9zaXRvcnlSZXF1ZXN0EhUKDXJlcG9zaXRvcnlfaWQYASABKAUSLgoFdW50aWwYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESGAoQdW50aWxfbmV4dF9tYWpvchgDIAEoCEIICgZfdW50aWwiGgoYU25vb3plUmVwb3NpdG9yeVJlc3BvbnNlIh0KG0dldE11dGVkUmVwb3NpdG9yaWVzUmVxdWVzdCJIChxHZXRNdXRlZFJlcG9zaXRvcmllc1Jlc3BvbnNlEigKDHJlcG9zaXRvcmllcxgBIAMoCzISLmFwaS52MS5SZXBvc2l0b3J5IkMKD1JlcG9zaXRvcnlHcm91cBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhYKDnJlcG9zaXRvcnlfaWRzGAMgAygFIhIKEEdldEdyb3Vwc1JlcXVlc3QiPAoRR2V0R3JvdXBzUmVzcG9uc2USJwoGZ3JvdXBzGAEgAygLMhcuYXBpLnYxLlJlcG9zaXRvcnlHcm91cCIiChJDcmVhdGVHcm91cFJlcXVlc3QSDAoEbmFtZRgBIAEoCSI9ChNDcmVhdGVHcm91cFJlc3BvbnNlEiYKBWdyb3VwGAEgASgLMhcuYXBpLnYxLlJlcG9zaXRvcnlHcm91cCI0ChJSZW5hbWVHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAUSDAoEbmFtZRgCIAEoCSIVChNSZW5hbWVHcm91cFJlc3BvbnNlIiYKEkRlbGV0ZUdyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoBSIVChNEZWxldGVHcm91cFJlc3BvbnNlIkYKG0FkZFJlcG9zaXRvcnlUb0dyb3VwUmVxdWVzdBIQCghncm91cF9pZBgBIAEoBRIVCg1yZXBvc2l0b3J5X2lkGAIgASgFIh4KHEFkZFJlcG9zaXRvcnlUb0dyb3VwUmVzcG9uc2UiSwogUmVtb3ZlUmVwb3NpdG9yeUZyb21Hcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAUSFQoNcmVwb3NpdG9yeV9pZBgCIAEoBSIjCiFSZW1vdmVSZXBvc2l0b3J5RnJvbUdyb3VwUmVzcG9uc2UiSgoIU3Rhckxpc3QSCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRIMCgRzbHVnGAMgASgJEhYKDnJlcG9zaXRvcnlfaWRzGAQgAygFIhEKD0dldExpc3RzUmVxdWVzdCIzChBHZXRMaXN0c1Jlc3BvbnNlEh8KBWxpc3RzGAEgAygLMhAuYXBpLnYxLlN0YXJMaXN0InEKDFJlbGVhc2VBc3NldBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhQKDGNvbnRlbnRfdHlwZRgDIAEoCRIMCgRzaXplGAQgASgDEhYKDmRvd25sb2FkX2NvdW50GAUgASgFEgsKA3VybBgGIAEoCSInChFHZXRSZWxlYXNlUmVxdWVzdBISCgpyZWxlYXNlX2lkGAEgASgFIoMCChJHZXRSZWxlYXNlUmVzcG9uc2USJgoHcmVsZWFzZRgBIAEoCzIVLmFwaS52MS5UaW1lbGluZUVudHJ5EiQKBmFzc2V0cxgCIAMoCzIULmFwaS52MS5SZWxlYXNlQXNzZXQSGAoQZGVzY3JpcHRpb25faHRtbBgDIAEoCRInCghwcmV2aW91cxgEIAEoCzIVLmFwaS52MS5UaW1lbGluZUVudHJ5EiMKBG5leHQYBSABKAsyFS5hcGkudjEuVGltZWxpbmVFbnRyeRITCgtjb21wYXJlX3VybBgGIAEoCRIiCgVlZGl0cxgHIAMoCzITLmFwaS52MS5SZWxlYXNlRWRpdCJ/CgtSZWxlYXNlRWRpdBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEhAKCHRhZ19uYW1lGAMgASgJEhUKDWlzX3ByZXJlbGVhc2UYBCABKAgSLQoJZWRpdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCCghEaWZmTGluZRIoCglvcGVyYXRpb24YASABKA4yFS5hcGkudjEuRGlmZk9wZXJhdGlvbhIMCgR0ZXh0GAIgASgJIk0KFUdldFJlbGVhc2VEaWZmUmVxdWVzdBISCgpyZWxlYXNlX2lkGAEgASgFEhQKB2VkaXRfaWQYAiABKAVIAIgBAUIKCghfZWRpdF9pZCKlAQoWR2V0UmVsZWFzZURpZmZSZXNwb25zZRIfCgVsaW5lcxgBIAMoCzIQLmFwaS52MS5EaWZmTGluZRItCgllZGl0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiEKGXByZXZpb3VzX2Rlc2NyaXB0aW9uX2h0bWwYAyABKAkSGAoQZGVzY3JpcHRpb25faHRtbBgEIAEoCSJMChlQcml2YXRlUmVwb3NpdG9yeVNldHRpbmdzEhQKDHN5bmNfcHJpdmF0ZRgBIAEoCBIZChFoYXNfcHJpdmF0ZV9zY29wZRgCIAEoCCIlCiNHZXRQcml2YXRlUmVwb3NpdG9yeVNldHRpbmdzUmVxdWVzdCJbCiRHZXRQcml2YXRlUmVwb3NpdG9yeVNldHRpbmdzUmVzcG9uc2USMwoIc2V0dGluZ3MYASABKAsyIS5hcGkudjEuUHJpdmF0ZVJlcG9zaXRvcnlTZXR0aW5ncyI+CiZVcGRhdGVQcml2YXRlUmVwb3NpdG9yeVNldHRpbmdzUmVxdWVzdBIUCgxzeW5jX3ByaXZhdGUYASABKAgiXgonVXBkYXRlUHJpdmF0ZVJlcG9zaXRvcnlTZXR0aW5nc1Jlc3BvbnNlEjMKCHNldHRpbmdzGAEgASgLMiEuYXBpLnYxLlByaXZhdGVSZXBvc2l0b3J5U2V0dGluZ3MiGAoWRGVsZXRlTXlBY2NvdW50UmVxdWVzdCIZChdEZWxldGVNeUFjY291bnRSZXNwb25zZSIVChNFeHBvcnRNeURhdGFSZXF1ZXN0IjoKFEV4cG9ydE15RGF0YVJlc3BvbnNlEg8KB2FyY2hpdmUYASABKAwSEQoJZmlsZV9uYW1lGAIgASgJIuMBCgdTZXNzaW9uEgoKAmlkGAEgASgJEhIKCnVzZXJfYWdlbnQYAiABKAkSEgoKaXBfYWRkcmVzcxgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmlzX2N1cnJlbnQYByABKAgiFAoSR2V0U2Vzc2lvbnNSZXF1ZXN0IjgKE0dldFNlc3Npb25zUmVzcG9uc2USIQoIc2Vzc2lvbnMYASADKAsyDy5hcGkudjEuU2Vzc2lvbiIqChRSZXZva2VTZXNzaW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJIhcKFVJldm9rZVNlc3Npb25SZXNwb25zZSIwChhSZXZva2VBbGxTZXNzaW9uc1JlcXVlc3QSFAoMa2VlcF9jdXJyZW50GAEgASgIIhsKGVJldm9rZUFsbFNlc3Npb25zUmVzcG9uc2Ui7QEKCEFwaVRva2VuEgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDgoGcHJlZml4GAMgASgJEiUKBnNjb3BlcxgEIAMoDjIVLmFwaS52MS5BcGlUb2tlblNjb3BlEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAifAoVQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0EgwKBG5hbWUYASABKAkSJQoGc2NvcGVzGAIgAygOMhUuYXBpLnYxLkFwaVRva2VuU2NvcGUSLgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiTAoWQ3JlYXRlQXBpVG9rZW5SZXNwb25zZRIjCglhcGlfdG9rZW4YASABKAsyEC5hcGkudjEuQXBpVG9rZW4SDQoFdG9rZW4YAiABKAkiFQoTR2V0QXBpVG9rZW5zUmVxdWVzdCI8ChRHZXRBcGlUb2tlbnNSZXNwb25zZRIkCgphcGlfdG9rZW5zGAEgAygLMhAuYXBpLnYxLkFwaVRva2VuIiMKFVJldm9rZUFwaVRva2VuUmVxdWVzdBIKCgJpZBgBIAEoBSIYChZSZXZva2VBcGlUb2tlblJlc3BvbnNlItABChZSZWxlYXNlSGlzdG9yeVNldHRpbmdzEiAKE3JlbGVhc2VfZmV0Y2hfZGVwdGgYASABKAVIAIgBARIeChFyZWxlYXNlX3JldGVudGlvbhgCIAEoBUgBiAEBEiMKG2RlZmF1bHRfcmVsZWFzZV9mZXRjaF9kZXB0aBgDIAEoBRIhChlkZWZhdWx0X3JlbGVhc2VfcmV0ZW50aW9uGAQgASgFQhYKFF9yZWxlYXNlX2ZldGNoX2RlcHRoQhQKEl9yZWxlYXNlX3JldGVudGlvbiIiCiBHZXRSZWxlYXNlSGlzdG9yeVNldHRpbmdzUmVxdWVzdCJVCiFHZXRSZWxlYXNlSGlzdG9yeVNldHRpbmdzUmVzcG9uc2USMAoIc2V0dGluZ3MYASABKAsyHi5hcGkudjEuUmVsZWFzZUhpc3RvcnlTZXR0aW5ncyKVAQojVXBkYXRlUmVsZWFzZUhpc3RvcnlTZXR0aW5nc1JlcXVlc3QSIAoTcmVsZWFzZV9mZXRjaF9kZXB0aBgBIAEoBUgAiAEBEh4KEXJlbGVhc2VfcmV0ZW50aW9uGAIgASgFSAGIAQFCFgoUX3JlbGVhc2VfZmV0Y2hfZGVwdGhCFAoSX3JlbGVhc2VfcmV0ZW50aW9uIlgKJFVwZGF0ZVJlbGVhc2VIaXN0b3J5U2V0dGluZ3NSZXNwb25zZRIwCghzZXR0aW5ncxgBIAEoCzIeLmFwaS52MS5SZWxlYXNlSGlzdG9yeVNldHRpbmdzIkcKDFNlYXJjaFJlc3VsdBImCgdyZWxlYXNlGAEgASgLMhUuYXBpLnYxLlRpbWVsaW5lRW50cnkSDwoHc25pcHBldBgCIAEoCSKCAgoVU2VhcmNoUmVsZWFzZXNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhIKCnByZXJlbGVhc2UYAiABKAgSGgoNcmVwb3NpdG9yeV9pZBgDIAEoBUgAiAEBEjcKDnJlbGVhc2VkX2FmdGVyGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEjgKD3JlbGVhc2VkX2JlZm9yZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBAUIQCg5fcmVwb3NpdG9yeV9pZEIRCg9fcmVsZWFzZWRfYWZ0ZXJCEgoQX3JlbGVhc2VkX2JlZm9yZSI/ChZTZWFyY2hSZWxlYXNlc1Jlc3BvbnNlEiUKB3Jlc3VsdHMYASADKAsyFC5hcGkudjEuU2VhcmNoUmVzdWx0IhoKGEdldExvZ2luUHJvdmlkZXJzUmVxdWVzdCJEChlHZXRMb2dpblByb3ZpZGVyc1Jlc3BvbnNlEhQKDG9pZGNfZW5hYmxlZBgBIAEoCBIRCglvaWRjX25hbWUYAiABKAkiFQoTUmVmcmVzaFRva2VuUmVxdWVzdCK+AQoUUmVmcmVzaFRva2VuUmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJEhUKDXJlZnJlc2hfdG9rZW4YAiABKAkSOwoXYWNjZXNzX3Rva2VuX2V4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjwKGHJlZnJlc2hfdG9rZW5fZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAqKQoSUmVwb3NpdG9yeVN0YXJUeXBlEggKBFNUQVIQABIJCgVXQVRDSBABKjIKDURpZmZPcGVyYXRpb24SCQoFRVFVQUwQABIKCgZJTlNFUlQQARIKCgZERUxFVEUQAiokCg1BcGlUb2tlblNjb3BlEggKBFJFQUQQABIJCgVXUklURRABMrcYCgpBcGlTZXJ2aWNlEjEKBFN5bmMSEy5hcGkudjEuU3luY1JlcXVlc3QaFC5hcGkudjEuU3luY1Jlc3BvbnNlElIKD0dldFJlcG9zaXRvcmllcxIeLmFwaS52MS5HZXRSZXBvc2l0b3JpZXNSZXF1ZXN0Gh8uYXBpLnYxLkdldFJlcG9zaXRvcmllc1Jlc3BvbnNlEmEKFFRvb2dsZVVzZXJQdWJsaWNGZWVkEiMuYXBpLnYxLlRvb2dsZVVzZXJQdWJsaWNGZWVkUmVxdWVzdBokLmFwaS52MS5Ub29nbGVVc2VyUHVibGljRmVlZFJlc3BvbnNlEkAKCUdldE15VXNlchIYLmFwaS52MS5HZXRNeVVzZXJSZXF1ZXN0GhkuYXBpLnYxLkdldE15VXNlclJlc3BvbnNlEjcKBkxvZ291dBIVLmFwaS52MS5Mb2dvdXRSZXF1ZXN0GhYuYXBpLnYxLkxvZ291dFJlc3BvbnNlEl4KE1RvZ2dsZVVzZXJPbmJvYXJkZWQSIi5hcGkudjEuVG9nZ2xlVXNlck9uYm9hcmRlZFJlcXVlc3QaIy5hcGkudjEuVG9nZ2xlVXNlck9uYm9hcmRlZFJlc3BvbnNlElIKD01hcmtSZWxlYXNlUmVhZBIeLmFwaS52MS5NYXJrUmVsZWFzZVJlYWRSZXF1ZXN0Gh8uYXBpLnYxLk1hcmtSZWxlYXNlUmVhZFJlc3BvbnNlElsKEk1hcmtSZXBvc2l0b3J5UmVhZBIhLmFwaS52MS5NYXJrUmVwb3NpdG9yeVJlYWRSZXF1ZXN0GiIuYXBpLnYxLk1hcmtSZXBvc2l0b3J5UmVhZFJlc3BvbnNlEkYKC01hcmtBbGxSZWFkEhouYXBpLnYxLk1hcmtBbGxSZWFkUmVxdWVzdBobLmFwaS52MS5NYXJrQWxsUmVhZFJlc3BvbnNlEkkKDEdldEJvb2ttYXJrcxIbLmFwaS52MS5HZXRCb29rbWFya3NSZXF1ZXN0GhwuYXBpLnYxLkdldEJvb2ttYXJrc1Jlc3BvbnNlEkYKC0FkZEJvb2ttYXJrEhouYXBpLnYxLkFkZEJvb2ttYXJrUmVxdWVzdBobLmFwaS52MS5BZGRCb29rbWFya1Jlc3BvbnNlEk8KDlJlbW92ZUJvb2ttYXJrEh0uYXBpLnYxLlJlbW92ZUJvb2ttYXJrUmVxdWVzdBoeLmFwaS52MS5SZW1vdmVCb29rbWFya1Jlc3BvbnNlEk8KDk11dGVSZXBvc2l0b3J5Eh0uYXBpLnYxLk11dGVSZXBvc2l0b3J5UmVxdWVzdBoeLmFwaS52MS5NdXRlUmVwb3NpdG9yeVJlc3BvbnNlElUKEFNub296ZVJlcG9zaXRvcnkSHy5hcGkudjEuU25vb3plUmVwb3NpdG9yeVJlcXVlc3QaIC5hcGkudjEuU25vb3plUmVwb3NpdG9yeVJlc3BvbnNlEmEKFEdldE11dGVkUmVwb3NpdG9yaWVzEiMuYXBpLnYxLkdldE11dGVkUmVwb3NpdG9yaWVzUmVxdWVzdBokLmFwaS52MS5HZXRNdXRlZFJlcG9zaXRvcmllc1Jlc3BvbnNlEkAKCUdldEdyb3VwcxIYLmFwaS52MS5HZXRHcm91cHNSZXF1ZXN0GhkuYXBpLnYxLkdldEdyb3Vwc1Jlc3BvbnNlEkYKC0NyZWF0ZUdyb3VwEhouYXBpLnYxLkNyZWF0ZUdyb3VwUmVxdWVzdBobLmFwaS52MS5DcmVhdGVHcm91cFJlc3BvbnNlEkYKC1JlbmFtZUdyb3VwEhouYXBpLnYxLlJlbmFtZUdyb3VwUmVxdWVzdBobLmFwaS52MS5SZW5hbWVHcm91cFJlc3BvbnNlEkYKC0RlbGV0ZUdyb3VwEhouYXBpLnYxLkRlbGV0ZUdyb3VwUmVxdWVzdBobLmFwaS52MS5EZWxldGVHcm91cFJlc3BvbnNlEmEKFEFkZFJlcG9zaXRvcnlUb0dyb3VwEiMuYXBpLnYxLkFkZFJlcG9zaXRvcnlUb0dyb3VwUmVxdWVzdBokLmFwaS52MS5BZGRSZXBvc2l0b3J5VG9Hcm91cFJlc3BvbnNlEnAKGVJlbW92ZVJlcG9zaXRvcnlGcm9tR3JvdXASKC5hcGkudjEuUmVtb3ZlUmVwb3NpdG9yeUZyb21Hcm91cFJlcXVlc3QaKS5hcGkudjEuUmVtb3ZlUmVwb3NpdG9yeUZyb21Hcm91cFJlc3BvbnNlEj0KCEdldExpc3RzEhcuYXBpLnYxLkdldExpc3RzUmVxdWVzdBoYLmFwaS52MS5HZXRMaXN0c1Jlc3BvbnNlEk8KDlNlYXJjaFJlbGVhc2VzEh0uYXBpLnYxLlNlYXJjaFJlbGVhc2VzUmVxdWVzdBoeLmFwaS52MS5TZWFyY2hSZWxlYXNlc1Jlc3BvbnNlEkMKCkdldFJlbGVhc2USGS5hcGkudjEuR2V0UmVsZWFzZVJlcXVlc3QaGi5hcGkudjEuR2V0UmVsZWFzZVJlc3BvbnNlEk8KDkdldFJlbGVhc2VEaWZmEh0uYXBpLnYxLkdldFJlbGVhc2VEaWZmUmVxdWVzdBoeLmFwaS52MS5HZXRSZWxlYXNlRGlmZlJlc3BvbnNlEnAKGUdldFJlbGVhc2VIaXN0b3J5U2V0dGluZ3MSKC5hcGkudjEuR2V0UmVsZWFzZUhpc3RvcnlTZXR0aW5nc1JlcXVlc3QaKS5hcGkudjEuR2V0UmVsZWFzZUhpc3RvcnlTZXR0aW5nc1Jlc3BvbnNlEnkKHFVwZGF0ZVJlbGVhc2VIaXN0b3J5U2V0dGluZ3MSKy5hcGkudjEuVXBkYXRlUmVsZWFzZUhpc3RvcnlTZXR0aW5nc1JlcXVlc3QaLC5hcGkudjEuVXBkYXRlUmVsZWFzZUhpc3RvcnlTZXR0aW5nc1Jlc3BvbnNlEkYKC0dldFNlc3Npb25zEhouYXBpLnYxLkdldFNlc3Npb25zUmVxdWVzdBobLmFwaS52MS5HZXRTZXNzaW9uc1Jlc3BvbnNlEkwKDVJldm9rZVNlc3Npb24SHC5hcGkudjEuUmV2b2tlU2Vzc2lvblJlcXVlc3QaHS5hcGkudjEuUmV2b2tlU2Vzc2lvblJlc3BvbnNlElgKEVJldm9rZUFsbFNlc3Npb25zEiAuYXBpLnYxLlJldm9rZUFsbFNlc3Npb25zUmVxdWVzdBohLmFwaS52MS5SZXZva2VBbGxTZXNzaW9uc1Jlc3BvbnNlEk8KDkNyZWF0ZUFwaVRva2VuEh0uYXBpLnYxLkNyZWF0ZUFwaVRva2VuUmVxdWVzdBoeLmFwaS52MS5DcmVhdGVBcGlUb2tlblJlc3BvbnNlEkkKDEdldEFwaVRva2VucxIbLmFwaS52MS5HZXRBcGlUb2tlbnNSZXF1ZXN0GhwuYXBpLnYxLkdldEFwaVRva2Vuc1Jlc3BvbnNlEk8KDlJldm9rZUFwaVRva2VuEh0uYXBpLnYxLlJldm9rZUFwaVRva2VuUmVxdWVzdBoeLmFwaS52MS5SZXZva2VBcGlUb2tlblJlc3BvbnNlEnkKHEdldFByaXZhdGVSZXBvc2l0b3J5U2V0dGluZ3MSKy5hcGkudjEuR2V0UHJpdmF0ZVJlcG9zaXRvcnlTZXR0aW5nc1JlcXVlc3QaLC5hcGkudjEuR2V0UHJpdmF0ZVJlcG9zaXRvcnlTZXR0aW5nc1Jlc3BvbnNlEoIBCh9VcGRhdGVQcml2YXRlUmVwb3NpdG9yeVNldHRpbmdzEi4uYXBpLnYxLlVwZGF0ZVByaXZhdGVSZXBvc2l0b3J5U2V0dGluZ3NSZXF1ZXN0Gi8uYXBpLnYxLlVwZGF0ZVByaXZhdGVSZXBvc2l0b3J5U2V0dGluZ3NSZXNwb25zZRJSCg9EZWxldGVNeUFjY291bnQSHi5hcGkudjEuRGVsZXRlTXlBY2NvdW50UmVxdWVzdBofLmFwaS52MS5EZWxldGVNeUFjY291bnRSZXNwb25zZRJJCgxFeHBvcnRNeURhdGESGy5hcGkudjEuRXhwb3J0TXlEYXRhUmVxdWVzdBocLmFwaS52MS5FeHBvcnRNeURhdGFSZXNwb25zZTKyAQoLQXV0aFNlcnZpY2USSQoMUmVmcmVzaFRva2VuEhsuYXBpLnYxLlJlZnJlc2hUb2tlblJlcXVlc3QaHC5hcGkudjEuUmVmcmVzaFRva2VuUmVzcG9uc2USWAoRR2V0TG9naW5Qcm92aWRlcnMSIC5hcGkudjEuR2V0TG9naW5Qcm92aWRlcnNSZXF1ZXN0GiEuYXBpLnYxLkdldExvZ2luUHJvdmlkZXJzUmVzcG9uc2VCPVo7Z2l0aHViLmNvbS9iZW5qYXNwZXIvcmVsZWFzZXMub25lL2ludGVybmFsL2dlbi9hcGkvdjE7YXBpdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message api.v1.Release
@@ -69,6 +69,26 @@ export type Repository = Message<"api.v1.Repository"> & {
    * @generated from field: string image_url = 4;
    */
   imageUrl: string;
+
+  /**
+   * @generated from field: int32 id = 5;
+   */
+  id: number;
+
+  /**
+   * @generated from field: bool is_muted = 6;
+   */
+  isMuted: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp snoozed_until = 7;
+   */
+  snoozedUntil?: Timestamp;
+
+  /**
+   * @generated from field: optional int32 snoozed_until_major = 8;
+   */
+  snoozedUntilMajor?: number;
 };
 
 /**
@@ -146,6 +166,61 @@ export type TimelineEntry = Message<"api.v1.TimelineEntry"> & {
    * @generated from field: api.v1.RepositoryStarType star_type = 13;
    */
   starType: RepositoryStarType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_seen_at = 14;
+   */
+  firstSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: bool is_backfill = 15;
+   */
+  isBackfill: boolean;
+
+  /**
+   * @generated from field: bool is_read = 16;
+   */
+  isRead: boolean;
+
+  /**
+   * @generated from field: bool is_security = 17;
+   */
+  isSecurity: boolean;
+
+  /**
+   * @generated from field: bool is_breaking = 18;
+   */
+  isBreaking: boolean;
+
+  /**
+   * @generated from field: bool has_deprecation = 19;
+   */
+  hasDeprecation: boolean;
+
+  /**
+   * @generated from field: bool has_migration_guide = 20;
+   */
+  hasMigrationGuide: boolean;
+
+  /**
+   * @generated from field: repeated string vulnerability_ids = 21;
+   */
+  vulnerabilityIds: string[];
+
+  /**
+   * @generated from field: repeated api.v1.Advisory advisories = 22;
+   */
+  advisories: Advisory[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited_at = 23;
+   */
+  editedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp retracted_at = 24;
+   */
+  retractedAt?: Timestamp;
 };
 
 /**
@@ -155,6 +230,48 @@ export type TimelineEntry = Message<"api.v1.TimelineEntry"> & {
 export const TimelineEntrySchema: GenMessage<TimelineEntry> = /*@__PURE__*/
   messageDesc(file_api_v1_api, 2);
 
+/**
+ * @generated from message api.v1.Advisory
+ */
+export type Advisory = Message<"api.v1.Advisory"> & {
+  /**
+   * @generated from field: string ghsa_id = 1;
+   */
+  ghsaId: string;
+
+  /**
+   * @generated from field: string cve_id = 2;
+   */
+  cveId: string;
+
+  /**
+   * @generated from field: string severity = 3;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: string summary = 4;
+   */
+  summary: string;
+
+  /**
+   * @generated from field: string url = 5;
+   */
+  url: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp published_at = 6;
+   */
+  publishedAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Advisory.
+ * Use `create(AdvisorySchema)` to create a new message.
+ */
+export const AdvisorySchema: GenMessage<Advisory> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 3);
+
 /**
  * @generated from message api.v1.SyncRequest
  */
@@ -170,7 +287,7 @@ export type SyncRequest = Message<"api.v1.SyncRequest"> & {
  * Use `create(SyncRequestSchema)` to create a new message.
  */
 export const SyncRequestSchema: GenMessage<SyncRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 3);
+  messageDesc(file_api_v1_api, 4);
 
 /**
  * @generated from message api.v1.SyncResponse
@@ -192,7 +309,7 @@ export type SyncResponse = Message<"api.v1.SyncResponse"> & {
  * Use `create(SyncResponseSchema)` to create a new message.
  */
 export const SyncResponseSchema: GenMessage<SyncResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 4);
+  messageDesc(file_api_v1_api, 5);
 
 /**
  * @generated from message api.v1.GetRepositoriesRequest
@@ -207,6 +324,36 @@ export type GetRepositoriesRequest = Message<"api.v1.GetRepositoriesRequest"> &
    * @generated from field: optional api.v1.RepositoryStarType star_type = 2;
    */
   starType?: RepositoryStarType;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp new_since = 3;
+   */
+  newSince?: Timestamp;
+
+  /**
+   * @generated from field: bool unread_only = 4;
+   */
+  unreadOnly: boolean;
+
+  /**
+   * @generated from field: optional int32 group_id = 5;
+   */
+  groupId?: number;
+
+  /**
+   * @generated from field: optional int32 list_id = 6;
+   */
+  listId?: number;
+
+  /**
+   * @generated from field: optional bool security = 7;
+   */
+  security?: boolean;
+
+  /**
+   * @generated from field: optional bool breaking = 8;
+   */
+  breaking?: boolean;
 };
 
 /**
@@ -214,7 +361,7 @@ export type GetRepositoriesRequest = Message<"api.v1.GetRepositoriesRequest"> &
  * Use `create(GetRepositoriesRequestSchema)` to create a new message.
  */
 export const GetRepositoriesRequestSchema: GenMessage<GetRepositoriesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 5);
+  messageDesc(file_api_v1_api, 6);
 
 /**
  * @generated from message api.v1.GetRepositoriesResponse
@@ -224,6 +371,11 @@ export type GetRepositoriesResponse = Message<"api.v1.GetRepositoriesResponse">
    * @generated from field: repeated api.v1.TimelineEntry timeline = 1;
    */
   timeline: TimelineEntry[];
+
+  /**
+   * @generated from field: int32 unread_count = 2;
+   */
+  unreadCount: number;
 };
 
 /**
@@ -231,7 +383,7 @@ export type GetRepositoriesResponse = Message<"api.v1.GetRepositoriesResponse">
  * Use `create(GetRepositoriesResponseSchema)` to create a new message.
  */
 export const GetRepositoriesResponseSchema: GenMessage<GetRepositoriesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 6);
+  messageDesc(file_api_v1_api, 7);
 
 /**
  * @generated from message api.v1.ToogleUserPublicFeedRequest
@@ -248,7 +400,7 @@ export type ToogleUserPublicFeedRequest = Message<"api.v1.ToogleUserPublicFeedRe
  * Use `create(ToogleUserPublicFeedRequestSchema)` to create a new message.
  */
 export const ToogleUserPublicFeedRequestSchema: GenMessage<ToogleUserPublicFeedRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 7);
+  messageDesc(file_api_v1_api, 8);
 
 /**
  * @generated from message api.v1.ToogleUserPublicFeedResponse
@@ -265,7 +417,7 @@ export type ToogleUserPublicFeedResponse = Message<"api.v1.ToogleUserPublicFeedR
  * Use `create(ToogleUserPublicFeedResponseSchema)` to create a new message.
  */
 export const ToogleUserPublicFeedResponseSchema: GenMessage<ToogleUserPublicFeedResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 8);
+  messageDesc(file_api_v1_api, 9);
 
 /**
  * @generated from message api.v1.GetMyUserRequest
@@ -278,7 +430,7 @@ export type GetMyUserRequest = Message<"api.v1.GetMyUserRequest"> & {
  * Use `create(GetMyUserRequestSchema)` to create a new message.
  */
 export const GetMyUserRequestSchema: GenMessage<GetMyUserRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 9);
+  messageDesc(file_api_v1_api, 10);
 
 /**
  * @generated from message api.v1.GetMyUserResponse
@@ -313,6 +465,11 @@ export type GetMyUserResponse = Message<"api.v1.GetMyUserResponse"> & {
    * @generated from field: bool is_onboarded = 6;
    */
   isOnboarded: boolean;
+
+  /**
+   * @generated from field: bool github_linked = 7;
+   */
+  githubLinked: boolean;
 };
 
 /**
@@ -320,7 +477,7 @@ export type GetMyUserResponse = Message<"api.v1.GetMyUserResponse"> & {
  * Use `create(GetMyUserResponseSchema)` to create a new message.
  */
 export const GetMyUserResponseSchema: GenMessage<GetMyUserResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 10);
+  messageDesc(file_api_v1_api, 11);
 
 /**
  * @generated from message api.v1.LogoutRequest
@@ -333,7 +490,7 @@ export type LogoutRequest = Message<"api.v1.LogoutRequest"> & {
  * Use `create(LogoutRequestSchema)` to create a new message.
  */
 export const LogoutRequestSchema: GenMessage<LogoutRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 11);
+  messageDesc(file_api_v1_api, 12);
 
 /**
  * @generated from message api.v1.LogoutResponse
@@ -346,7 +503,7 @@ export type LogoutResponse = Message<"api.v1.LogoutResponse"> & {
  * Use `create(LogoutResponseSchema)` to create a new message.
  */
 export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 12);
+  messageDesc(file_api_v1_api, 13);
 
 /**
  * @generated from message api.v1.ToggleUserOnboardedRequest
@@ -359,7 +516,7 @@ export type ToggleUserOnboardedRequest = Message<"api.v1.ToggleUserOnboardedRequ
  * Use `create(ToggleUserOnboardedRequestSchema)` to create a new message.
  */
 export const ToggleUserOnboardedRequestSchema: GenMessage<ToggleUserOnboardedRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 13);
+  messageDesc(file_api_v1_api, 14);
 
 /**
  * @generated from message api.v1.ToggleUserOnboardedResponse
@@ -372,110 +529,1639 @@ export type ToggleUserOnboardedResponse = Message<"api.v1.ToggleUserOnboardedRes
  * Use `create(ToggleUserOnboardedResponseSchema)` to create a new message.
  */
 export const ToggleUserOnboardedResponseSchema: GenMessage<ToggleUserOnboardedResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 14);
+  messageDesc(file_api_v1_api, 15);
 
 /**
- * @generated from message api.v1.RefreshTokenRequest
+ * @generated from message api.v1.MarkReleaseReadRequest
  */
-export type RefreshTokenRequest = Message<"api.v1.RefreshTokenRequest"> & {
+export type MarkReleaseReadRequest = Message<"api.v1.MarkReleaseReadRequest"> & {
+  /**
+   * @generated from field: int32 release_id = 1;
+   */
+  releaseId: number;
 };
 
 /**
- * Describes the message api.v1.RefreshTokenRequest.
- * Use `create(RefreshTokenRequestSchema)` to create a new message.
+ * Describes the message api.v1.MarkReleaseReadRequest.
+ * Use `create(MarkReleaseReadRequestSchema)` to create a new message.
  */
-export const RefreshTokenRequestSchema: GenMessage<RefreshTokenRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 15);
+export const MarkReleaseReadRequestSchema: GenMessage<MarkReleaseReadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 16);
 
 /**
- * @generated from message api.v1.RefreshTokenResponse
+ * @generated from message api.v1.MarkReleaseReadResponse
  */
-export type RefreshTokenResponse = Message<"api.v1.RefreshTokenResponse"> & {
+export type MarkReleaseReadResponse = Message<"api.v1.MarkReleaseReadResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.MarkReleaseReadResponse.
+ * Use `create(MarkReleaseReadResponseSchema)` to create a new message.
+ */
+export const MarkReleaseReadResponseSchema: GenMessage<MarkReleaseReadResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 17);
+
+/**
+ * @generated from message api.v1.MarkRepositoryReadRequest
+ */
+export type MarkRepositoryReadRequest = Message<"api.v1.MarkRepositoryReadRequest"> & {
   /**
-   * @generated from field: string access_token = 1;
+   * @generated from field: int32 repository_id = 1;
    */
-  accessToken: string;
+  repositoryId: number;
+};
+
+/**
+ * Describes the message api.v1.MarkRepositoryReadRequest.
+ * Use `create(MarkRepositoryReadRequestSchema)` to create a new message.
+ */
+export const MarkRepositoryReadRequestSchema: GenMessage<MarkRepositoryReadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 18);
+
+/**
+ * @generated from message api.v1.MarkRepositoryReadResponse
+ */
+export type MarkRepositoryReadResponse = Message<"api.v1.MarkRepositoryReadResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.MarkRepositoryReadResponse.
+ * Use `create(MarkRepositoryReadResponseSchema)` to create a new message.
+ */
+export const MarkRepositoryReadResponseSchema: GenMessage<MarkRepositoryReadResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 19);
+
+/**
+ * @generated from message api.v1.MarkAllReadRequest
+ */
+export type MarkAllReadRequest = Message<"api.v1.MarkAllReadRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.MarkAllReadRequest.
+ * Use `create(MarkAllReadRequestSchema)` to create a new message.
+ */
+export const MarkAllReadRequestSchema: GenMessage<MarkAllReadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 20);
+
+/**
+ * @generated from message api.v1.MarkAllReadResponse
+ */
+export type MarkAllReadResponse = Message<"api.v1.MarkAllReadResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.MarkAllReadResponse.
+ * Use `create(MarkAllReadResponseSchema)` to create a new message.
+ */
+export const MarkAllReadResponseSchema: GenMessage<MarkAllReadResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 21);
 
+/**
+ * @generated from message api.v1.Bookmark
+ */
+export type Bookmark = Message<"api.v1.Bookmark"> & {
   /**
-   * @generated from field: string refresh_token = 2;
+   * @generated from field: api.v1.TimelineEntry release = 1;
    */
-  refreshToken: string;
+  release?: TimelineEntry;
 
   /**
-   * @generated from field: google.protobuf.Timestamp access_token_expires_at = 3;
+   * @generated from field: string note = 2;
    */
-  accessTokenExpiresAt?: Timestamp;
+  note: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp refresh_token_expires_at = 4;
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
    */
-  refreshTokenExpiresAt?: Timestamp;
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
 };
 
 /**
- * Describes the message api.v1.RefreshTokenResponse.
- * Use `create(RefreshTokenResponseSchema)` to create a new message.
+ * Describes the message api.v1.Bookmark.
+ * Use `create(BookmarkSchema)` to create a new message.
  */
-export const RefreshTokenResponseSchema: GenMessage<RefreshTokenResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_api, 16);
+export const BookmarkSchema: GenMessage<Bookmark> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 22);
 
 /**
- * @generated from enum api.v1.RepositoryStarType
+ * @generated from message api.v1.GetBookmarksRequest
  */
-export enum RepositoryStarType {
+export type GetBookmarksRequest = Message<"api.v1.GetBookmarksRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetBookmarksRequest.
+ * Use `create(GetBookmarksRequestSchema)` to create a new message.
+ */
+export const GetBookmarksRequestSchema: GenMessage<GetBookmarksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 23);
+
+/**
+ * @generated from message api.v1.GetBookmarksResponse
+ */
+export type GetBookmarksResponse = Message<"api.v1.GetBookmarksResponse"> & {
   /**
-   * @generated from enum value: STAR = 0;
+   * @generated from field: repeated api.v1.Bookmark bookmarks = 1;
    */
-  STAR = 0,
+  bookmarks: Bookmark[];
 
   /**
-   * @generated from enum value: WATCH = 1;
+   * @generated from field: string private_feed_id = 2;
    */
-  WATCH = 1,
-}
+  privateFeedId: string;
+};
 
 /**
- * Describes the enum api.v1.RepositoryStarType.
+ * Describes the message api.v1.GetBookmarksResponse.
+ * Use `create(GetBookmarksResponseSchema)` to create a new message.
  */
-export const RepositoryStarTypeSchema: GenEnum<RepositoryStarType> = /*@__PURE__*/
-  enumDesc(file_api_v1_api, 0);
+export const GetBookmarksResponseSchema: GenMessage<GetBookmarksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 24);
 
 /**
- * @generated from service api.v1.ApiService
+ * @generated from message api.v1.AddBookmarkRequest
  */
-export const ApiService: GenService<{
+export type AddBookmarkRequest = Message<"api.v1.AddBookmarkRequest"> & {
   /**
-   * @generated from rpc api.v1.ApiService.Sync
+   * @generated from field: int32 release_id = 1;
    */
-  sync: {
-    methodKind: "unary";
-    input: typeof SyncRequestSchema;
-    output: typeof SyncResponseSchema;
-  },
+  releaseId: number;
+
   /**
-   * @generated from rpc api.v1.ApiService.GetRepositories
+   * @generated from field: string note = 2;
    */
-  getRepositories: {
-    methodKind: "unary";
-    input: typeof GetRepositoriesRequestSchema;
-    output: typeof GetRepositoriesResponseSchema;
-  },
+  note: string;
+};
+
+/**
+ * Describes the message api.v1.AddBookmarkRequest.
+ * Use `create(AddBookmarkRequestSchema)` to create a new message.
+ */
+export const AddBookmarkRequestSchema: GenMessage<AddBookmarkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 25);
+
+/**
+ * @generated from message api.v1.AddBookmarkResponse
+ */
+export type AddBookmarkResponse = Message<"api.v1.AddBookmarkResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.AddBookmarkResponse.
+ * Use `create(AddBookmarkResponseSchema)` to create a new message.
+ */
+export const AddBookmarkResponseSchema: GenMessage<AddBookmarkResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 26);
+
+/**
+ * @generated from message api.v1.RemoveBookmarkRequest
+ */
+export type RemoveBookmarkRequest = Message<"api.v1.RemoveBookmarkRequest"> & {
   /**
-   * @generated from rpc api.v1.ApiService.ToogleUserPublicFeed
+   * @generated from field: int32 release_id = 1;
    */
-  toogleUserPublicFeed: {
-    methodKind: "unary";
-    input: typeof ToogleUserPublicFeedRequestSchema;
-    output: typeof ToogleUserPublicFeedResponseSchema;
-  },
+  releaseId: number;
+};
+
+/**
+ * Describes the message api.v1.RemoveBookmarkRequest.
+ * Use `create(RemoveBookmarkRequestSchema)` to create a new message.
+ */
+export const RemoveBookmarkRequestSchema: GenMessage<RemoveBookmarkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 27);
+
+/**
+ * @generated from message api.v1.RemoveBookmarkResponse
+ */
+export type RemoveBookmarkResponse = Message<"api.v1.RemoveBookmarkResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RemoveBookmarkResponse.
+ * Use `create(RemoveBookmarkResponseSchema)` to create a new message.
+ */
+export const RemoveBookmarkResponseSchema: GenMessage<RemoveBookmarkResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 28);
+
+/**
+ * @generated from message api.v1.MuteRepositoryRequest
+ */
+export type MuteRepositoryRequest = Message<"api.v1.MuteRepositoryRequest"> & {
   /**
-   * @generated from rpc api.v1.ApiService.GetMyUser
+   * @generated from field: int32 repository_id = 1;
    */
-  getMyUser: {
-    methodKind: "unary";
-    input: typeof GetMyUserRequestSchema;
-    output: typeof GetMyUserResponseSchema;
-  },
+  repositoryId: number;
+
+  /**
+   * @generated from field: bool muted = 2;
+   */
+  muted: boolean;
+};
+
+/**
+ * Describes the message api.v1.MuteRepositoryRequest.
+ * Use `create(MuteRepositoryRequestSchema)` to create a new message.
+ */
+export const MuteRepositoryRequestSchema: GenMessage<MuteRepositoryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 29);
+
+/**
+ * @generated from message api.v1.MuteRepositoryResponse
+ */
+export type MuteRepositoryResponse = Message<"api.v1.MuteRepositoryResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.MuteRepositoryResponse.
+ * Use `create(MuteRepositoryResponseSchema)` to create a new message.
+ */
+export const MuteRepositoryResponseSchema: GenMessage<MuteRepositoryResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 30);
+
+/**
+ * @generated from message api.v1.SnoozeRepositoryRequest
+ */
+export type SnoozeRepositoryRequest = Message<"api.v1.SnoozeRepositoryRequest"> & {
+  /**
+   * @generated from field: int32 repository_id = 1;
+   */
+  repositoryId: number;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp until = 2;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: bool until_next_major = 3;
+   */
+  untilNextMajor: boolean;
+};
+
+/**
+ * Describes the message api.v1.SnoozeRepositoryRequest.
+ * Use `create(SnoozeRepositoryRequestSchema)` to create a new message.
+ */
+export const SnoozeRepositoryRequestSchema: GenMessage<SnoozeRepositoryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 31);
+
+/**
+ * @generated from message api.v1.SnoozeRepositoryResponse
+ */
+export type SnoozeRepositoryResponse = Message<"api.v1.SnoozeRepositoryResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.SnoozeRepositoryResponse.
+ * Use `create(SnoozeRepositoryResponseSchema)` to create a new message.
+ */
+export const SnoozeRepositoryResponseSchema: GenMessage<SnoozeRepositoryResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 32);
+
+/**
+ * @generated from message api.v1.GetMutedRepositoriesRequest
+ */
+export type GetMutedRepositoriesRequest = Message<"api.v1.GetMutedRepositoriesRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetMutedRepositoriesRequest.
+ * Use `create(GetMutedRepositoriesRequestSchema)` to create a new message.
+ */
+export const GetMutedRepositoriesRequestSchema: GenMessage<GetMutedRepositoriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 33);
+
+/**
+ * @generated from message api.v1.GetMutedRepositoriesResponse
+ */
+export type GetMutedRepositoriesResponse = Message<"api.v1.GetMutedRepositoriesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Repository repositories = 1;
+   */
+  repositories: Repository[];
+};
+
+/**
+ * Describes the message api.v1.GetMutedRepositoriesResponse.
+ * Use `create(GetMutedRepositoriesResponseSchema)` to create a new message.
+ */
+export const GetMutedRepositoriesResponseSchema: GenMessage<GetMutedRepositoriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 34);
+
+/**
+ * @generated from message api.v1.RepositoryGroup
+ */
+export type RepositoryGroup = Message<"api.v1.RepositoryGroup"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated int32 repository_ids = 3;
+   */
+  repositoryIds: number[];
+};
+
+/**
+ * Describes the message api.v1.RepositoryGroup.
+ * Use `create(RepositoryGroupSchema)` to create a new message.
+ */
+export const RepositoryGroupSchema: GenMessage<RepositoryGroup> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 35);
+
+/**
+ * @generated from message api.v1.GetGroupsRequest
+ */
+export type GetGroupsRequest = Message<"api.v1.GetGroupsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetGroupsRequest.
+ * Use `create(GetGroupsRequestSchema)` to create a new message.
+ */
+export const GetGroupsRequestSchema: GenMessage<GetGroupsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 36);
+
+/**
+ * @generated from message api.v1.GetGroupsResponse
+ */
+export type GetGroupsResponse = Message<"api.v1.GetGroupsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.RepositoryGroup groups = 1;
+   */
+  groups: RepositoryGroup[];
+};
+
+/**
+ * Describes the message api.v1.GetGroupsResponse.
+ * Use `create(GetGroupsResponseSchema)` to create a new message.
+ */
+export const GetGroupsResponseSchema: GenMessage<GetGroupsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 37);
+
+/**
+ * @generated from message api.v1.CreateGroupRequest
+ */
+export type CreateGroupRequest = Message<"api.v1.CreateGroupRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.CreateGroupRequest.
+ * Use `create(CreateGroupRequestSchema)` to create a new message.
+ */
+export const CreateGroupRequestSchema: GenMessage<CreateGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 38);
+
+/**
+ * @generated from message api.v1.CreateGroupResponse
+ */
+export type CreateGroupResponse = Message<"api.v1.CreateGroupResponse"> & {
+  /**
+   * @generated from field: api.v1.RepositoryGroup group = 1;
+   */
+  group?: RepositoryGroup;
+};
+
+/**
+ * Describes the message api.v1.CreateGroupResponse.
+ * Use `create(CreateGroupResponseSchema)` to create a new message.
+ */
+export const CreateGroupResponseSchema: GenMessage<CreateGroupResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 39);
+
+/**
+ * @generated from message api.v1.RenameGroupRequest
+ */
+export type RenameGroupRequest = Message<"api.v1.RenameGroupRequest"> & {
+  /**
+   * @generated from field: int32 group_id = 1;
+   */
+  groupId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.RenameGroupRequest.
+ * Use `create(RenameGroupRequestSchema)` to create a new message.
+ */
+export const RenameGroupRequestSchema: GenMessage<RenameGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 40);
+
+/**
+ * @generated from message api.v1.RenameGroupResponse
+ */
+export type RenameGroupResponse = Message<"api.v1.RenameGroupResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RenameGroupResponse.
+ * Use `create(RenameGroupResponseSchema)` to create a new message.
+ */
+export const RenameGroupResponseSchema: GenMessage<RenameGroupResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 41);
+
+/**
+ * @generated from message api.v1.DeleteGroupRequest
+ */
+export type DeleteGroupRequest = Message<"api.v1.DeleteGroupRequest"> & {
+  /**
+   * @generated from field: int32 group_id = 1;
+   */
+  groupId: number;
+};
+
+/**
+ * Describes the message api.v1.DeleteGroupRequest.
+ * Use `create(DeleteGroupRequestSchema)` to create a new message.
+ */
+export const DeleteGroupRequestSchema: GenMessage<DeleteGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 42);
+
+/**
+ * @generated from message api.v1.DeleteGroupResponse
+ */
+export type DeleteGroupResponse = Message<"api.v1.DeleteGroupResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteGroupResponse.
+ * Use `create(DeleteGroupResponseSchema)` to create a new message.
+ */
+export const DeleteGroupResponseSchema: GenMessage<DeleteGroupResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 43);
+
+/**
+ * @generated from message api.v1.AddRepositoryToGroupRequest
+ */
+export type AddRepositoryToGroupRequest = Message<"api.v1.AddRepositoryToGroupRequest"> & {
+  /**
+   * @generated from field: int32 group_id = 1;
+   */
+  groupId: number;
+
+  /**
+   * @generated from field: int32 repository_id = 2;
+   */
+  repositoryId: number;
+};
+
+/**
+ * Describes the message api.v1.AddRepositoryToGroupRequest.
+ * Use `create(AddRepositoryToGroupRequestSchema)` to create a new message.
+ */
+export const AddRepositoryToGroupRequestSchema: GenMessage<AddRepositoryToGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 44);
+
+/**
+ * @generated from message api.v1.AddRepositoryToGroupResponse
+ */
+export type AddRepositoryToGroupResponse = Message<"api.v1.AddRepositoryToGroupResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.AddRepositoryToGroupResponse.
+ * Use `create(AddRepositoryToGroupResponseSchema)` to create a new message.
+ */
+export const AddRepositoryToGroupResponseSchema: GenMessage<AddRepositoryToGroupResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 45);
+
+/**
+ * @generated from message api.v1.RemoveRepositoryFromGroupRequest
+ */
+export type RemoveRepositoryFromGroupRequest = Message<"api.v1.RemoveRepositoryFromGroupRequest"> & {
+  /**
+   * @generated from field: int32 group_id = 1;
+   */
+  groupId: number;
+
+  /**
+   * @generated from field: int32 repository_id = 2;
+   */
+  repositoryId: number;
+};
+
+/**
+ * Describes the message api.v1.RemoveRepositoryFromGroupRequest.
+ * Use `create(RemoveRepositoryFromGroupRequestSchema)` to create a new message.
+ */
+export const RemoveRepositoryFromGroupRequestSchema: GenMessage<RemoveRepositoryFromGroupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 46);
+
+/**
+ * @generated from message api.v1.RemoveRepositoryFromGroupResponse
+ */
+export type RemoveRepositoryFromGroupResponse = Message<"api.v1.RemoveRepositoryFromGroupResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RemoveRepositoryFromGroupResponse.
+ * Use `create(RemoveRepositoryFromGroupResponseSchema)` to create a new message.
+ */
+export const RemoveRepositoryFromGroupResponseSchema: GenMessage<RemoveRepositoryFromGroupResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 47);
+
+/**
+ * @generated from message api.v1.StarList
+ */
+export type StarList = Message<"api.v1.StarList"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string slug = 3;
+   */
+  slug: string;
+
+  /**
+   * @generated from field: repeated int32 repository_ids = 4;
+   */
+  repositoryIds: number[];
+};
+
+/**
+ * Describes the message api.v1.StarList.
+ * Use `create(StarListSchema)` to create a new message.
+ */
+export const StarListSchema: GenMessage<StarList> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 48);
+
+/**
+ * @generated from message api.v1.GetListsRequest
+ */
+export type GetListsRequest = Message<"api.v1.GetListsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetListsRequest.
+ * Use `create(GetListsRequestSchema)` to create a new message.
+ */
+export const GetListsRequestSchema: GenMessage<GetListsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 49);
+
+/**
+ * @generated from message api.v1.GetListsResponse
+ */
+export type GetListsResponse = Message<"api.v1.GetListsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.StarList lists = 1;
+   */
+  lists: StarList[];
+};
+
+/**
+ * Describes the message api.v1.GetListsResponse.
+ * Use `create(GetListsResponseSchema)` to create a new message.
+ */
+export const GetListsResponseSchema: GenMessage<GetListsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 50);
+
+/**
+ * @generated from message api.v1.ReleaseAsset
+ */
+export type ReleaseAsset = Message<"api.v1.ReleaseAsset"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int32 download_count = 5;
+   */
+  downloadCount: number;
+
+  /**
+   * @generated from field: string url = 6;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message api.v1.ReleaseAsset.
+ * Use `create(ReleaseAssetSchema)` to create a new message.
+ */
+export const ReleaseAssetSchema: GenMessage<ReleaseAsset> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 51);
+
+/**
+ * @generated from message api.v1.GetReleaseRequest
+ */
+export type GetReleaseRequest = Message<"api.v1.GetReleaseRequest"> & {
+  /**
+   * @generated from field: int32 release_id = 1;
+   */
+  releaseId: number;
+};
+
+/**
+ * Describes the message api.v1.GetReleaseRequest.
+ * Use `create(GetReleaseRequestSchema)` to create a new message.
+ */
+export const GetReleaseRequestSchema: GenMessage<GetReleaseRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 52);
+
+/**
+ * @generated from message api.v1.GetReleaseResponse
+ */
+export type GetReleaseResponse = Message<"api.v1.GetReleaseResponse"> & {
+  /**
+   * @generated from field: api.v1.TimelineEntry release = 1;
+   */
+  release?: TimelineEntry;
+
+  /**
+   * @generated from field: repeated api.v1.ReleaseAsset assets = 2;
+   */
+  assets: ReleaseAsset[];
+
+  /**
+   * @generated from field: string description_html = 3;
+   */
+  descriptionHtml: string;
+
+  /**
+   * @generated from field: api.v1.TimelineEntry previous = 4;
+   */
+  previous?: TimelineEntry;
+
+  /**
+   * @generated from field: api.v1.TimelineEntry next = 5;
+   */
+  next?: TimelineEntry;
+
+  /**
+   * @generated from field: string compare_url = 6;
+   */
+  compareUrl: string;
+
+  /**
+   * @generated from field: repeated api.v1.ReleaseEdit edits = 7;
+   */
+  edits: ReleaseEdit[];
+};
+
+/**
+ * Describes the message api.v1.GetReleaseResponse.
+ * Use `create(GetReleaseResponseSchema)` to create a new message.
+ */
+export const GetReleaseResponseSchema: GenMessage<GetReleaseResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 53);
+
+/**
+ * @generated from message api.v1.ReleaseEdit
+ */
+export type ReleaseEdit = Message<"api.v1.ReleaseEdit"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string tag_name = 3;
+   */
+  tagName: string;
+
+  /**
+   * @generated from field: bool is_prerelease = 4;
+   */
+  isPrerelease: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited_at = 5;
+   */
+  editedAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.ReleaseEdit.
+ * Use `create(ReleaseEditSchema)` to create a new message.
+ */
+export const ReleaseEditSchema: GenMessage<ReleaseEdit> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 54);
+
+/**
+ * @generated from message api.v1.DiffLine
+ */
+export type DiffLine = Message<"api.v1.DiffLine"> & {
+  /**
+   * @generated from field: api.v1.DiffOperation operation = 1;
+   */
+  operation: DiffOperation;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message api.v1.DiffLine.
+ * Use `create(DiffLineSchema)` to create a new message.
+ */
+export const DiffLineSchema: GenMessage<DiffLine> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 55);
+
+/**
+ * @generated from message api.v1.GetReleaseDiffRequest
+ */
+export type GetReleaseDiffRequest = Message<"api.v1.GetReleaseDiffRequest"> & {
+  /**
+   * @generated from field: int32 release_id = 1;
+   */
+  releaseId: number;
+
+  /**
+   * @generated from field: optional int32 edit_id = 2;
+   */
+  editId?: number;
+};
+
+/**
+ * Describes the message api.v1.GetReleaseDiffRequest.
+ * Use `create(GetReleaseDiffRequestSchema)` to create a new message.
+ */
+export const GetReleaseDiffRequestSchema: GenMessage<GetReleaseDiffRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 56);
+
+/**
+ * @generated from message api.v1.GetReleaseDiffResponse
+ */
+export type GetReleaseDiffResponse = Message<"api.v1.GetReleaseDiffResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.DiffLine lines = 1;
+   */
+  lines: DiffLine[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited_at = 2;
+   */
+  editedAt?: Timestamp;
+
+  /**
+   * @generated from field: string previous_description_html = 3;
+   */
+  previousDescriptionHtml: string;
+
+  /**
+   * @generated from field: string description_html = 4;
+   */
+  descriptionHtml: string;
+};
+
+/**
+ * Describes the message api.v1.GetReleaseDiffResponse.
+ * Use `create(GetReleaseDiffResponseSchema)` to create a new message.
+ */
+export const GetReleaseDiffResponseSchema: GenMessage<GetReleaseDiffResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 57);
+
+/**
+ * @generated from message api.v1.PrivateRepositorySettings
+ */
+export type PrivateRepositorySettings = Message<"api.v1.PrivateRepositorySettings"> & {
+  /**
+   * @generated from field: bool sync_private = 1;
+   */
+  syncPrivate: boolean;
+
+  /**
+   * @generated from field: bool has_private_scope = 2;
+   */
+  hasPrivateScope: boolean;
+};
+
+/**
+ * Describes the message api.v1.PrivateRepositorySettings.
+ * Use `create(PrivateRepositorySettingsSchema)` to create a new message.
+ */
+export const PrivateRepositorySettingsSchema: GenMessage<PrivateRepositorySettings> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 58);
+
+/**
+ * @generated from message api.v1.GetPrivateRepositorySettingsRequest
+ */
+export type GetPrivateRepositorySettingsRequest = Message<"api.v1.GetPrivateRepositorySettingsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetPrivateRepositorySettingsRequest.
+ * Use `create(GetPrivateRepositorySettingsRequestSchema)` to create a new message.
+ */
+export const GetPrivateRepositorySettingsRequestSchema: GenMessage<GetPrivateRepositorySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 59);
+
+/**
+ * @generated from message api.v1.GetPrivateRepositorySettingsResponse
+ */
+export type GetPrivateRepositorySettingsResponse = Message<"api.v1.GetPrivateRepositorySettingsResponse"> & {
+  /**
+   * @generated from field: api.v1.PrivateRepositorySettings settings = 1;
+   */
+  settings?: PrivateRepositorySettings;
+};
+
+/**
+ * Describes the message api.v1.GetPrivateRepositorySettingsResponse.
+ * Use `create(GetPrivateRepositorySettingsResponseSchema)` to create a new message.
+ */
+export const GetPrivateRepositorySettingsResponseSchema: GenMessage<GetPrivateRepositorySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 60);
+
+/**
+ * @generated from message api.v1.UpdatePrivateRepositorySettingsRequest
+ */
+export type UpdatePrivateRepositorySettingsRequest = Message<"api.v1.UpdatePrivateRepositorySettingsRequest"> & {
+  /**
+   * @generated from field: bool sync_private = 1;
+   */
+  syncPrivate: boolean;
+};
+
+/**
+ * Describes the message api.v1.UpdatePrivateRepositorySettingsRequest.
+ * Use `create(UpdatePrivateRepositorySettingsRequestSchema)` to create a new message.
+ */
+export const UpdatePrivateRepositorySettingsRequestSchema: GenMessage<UpdatePrivateRepositorySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 61);
+
+/**
+ * @generated from message api.v1.UpdatePrivateRepositorySettingsResponse
+ */
+export type UpdatePrivateRepositorySettingsResponse = Message<"api.v1.UpdatePrivateRepositorySettingsResponse"> & {
+  /**
+   * @generated from field: api.v1.PrivateRepositorySettings settings = 1;
+   */
+  settings?: PrivateRepositorySettings;
+};
+
+/**
+ * Describes the message api.v1.UpdatePrivateRepositorySettingsResponse.
+ * Use `create(UpdatePrivateRepositorySettingsResponseSchema)` to create a new message.
+ */
+export const UpdatePrivateRepositorySettingsResponseSchema: GenMessage<UpdatePrivateRepositorySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 62);
+
+/**
+ * @generated from message api.v1.DeleteMyAccountRequest
+ */
+export type DeleteMyAccountRequest = Message<"api.v1.DeleteMyAccountRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteMyAccountRequest.
+ * Use `create(DeleteMyAccountRequestSchema)` to create a new message.
+ */
+export const DeleteMyAccountRequestSchema: GenMessage<DeleteMyAccountRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 63);
+
+/**
+ * @generated from message api.v1.DeleteMyAccountResponse
+ */
+export type DeleteMyAccountResponse = Message<"api.v1.DeleteMyAccountResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteMyAccountResponse.
+ * Use `create(DeleteMyAccountResponseSchema)` to create a new message.
+ */
+export const DeleteMyAccountResponseSchema: GenMessage<DeleteMyAccountResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 64);
+
+/**
+ * @generated from message api.v1.ExportMyDataRequest
+ */
+export type ExportMyDataRequest = Message<"api.v1.ExportMyDataRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ExportMyDataRequest.
+ * Use `create(ExportMyDataRequestSchema)` to create a new message.
+ */
+export const ExportMyDataRequestSchema: GenMessage<ExportMyDataRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 65);
+
+/**
+ * @generated from message api.v1.ExportMyDataResponse
+ */
+export type ExportMyDataResponse = Message<"api.v1.ExportMyDataResponse"> & {
+  /**
+   * @generated from field: bytes archive = 1;
+   */
+  archive: Uint8Array;
+
+  /**
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+};
+
+/**
+ * Describes the message api.v1.ExportMyDataResponse.
+ * Use `create(ExportMyDataResponseSchema)` to create a new message.
+ */
+export const ExportMyDataResponseSchema: GenMessage<ExportMyDataResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 66);
+
+/**
+ * @generated from message api.v1.Session
+ */
+export type Session = Message<"api.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 5;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: bool is_current = 7;
+   */
+  isCurrent: boolean;
+};
+
+/**
+ * Describes the message api.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 67);
+
+/**
+ * @generated from message api.v1.GetSessionsRequest
+ */
+export type GetSessionsRequest = Message<"api.v1.GetSessionsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetSessionsRequest.
+ * Use `create(GetSessionsRequestSchema)` to create a new message.
+ */
+export const GetSessionsRequestSchema: GenMessage<GetSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 68);
+
+/**
+ * @generated from message api.v1.GetSessionsResponse
+ */
+export type GetSessionsResponse = Message<"api.v1.GetSessionsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message api.v1.GetSessionsResponse.
+ * Use `create(GetSessionsResponseSchema)` to create a new message.
+ */
+export const GetSessionsResponseSchema: GenMessage<GetSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 69);
+
+/**
+ * @generated from message api.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"api.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message api.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 70);
+
+/**
+ * @generated from message api.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"api.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 71);
+
+/**
+ * @generated from message api.v1.RevokeAllSessionsRequest
+ */
+export type RevokeAllSessionsRequest = Message<"api.v1.RevokeAllSessionsRequest"> & {
+  /**
+   * @generated from field: bool keep_current = 1;
+   */
+  keepCurrent: boolean;
+};
+
+/**
+ * Describes the message api.v1.RevokeAllSessionsRequest.
+ * Use `create(RevokeAllSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllSessionsRequestSchema: GenMessage<RevokeAllSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 72);
+
+/**
+ * @generated from message api.v1.RevokeAllSessionsResponse
+ */
+export type RevokeAllSessionsResponse = Message<"api.v1.RevokeAllSessionsResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RevokeAllSessionsResponse.
+ * Use `create(RevokeAllSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeAllSessionsResponseSchema: GenMessage<RevokeAllSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 73);
+
+/**
+ * @generated from message api.v1.ApiToken
+ */
+export type ApiToken = Message<"api.v1.ApiToken"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: repeated api.v1.ApiTokenScope scopes = 4;
+   */
+  scopes: ApiTokenScope[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 6;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 7;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 74);
+
+/**
+ * @generated from message api.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"api.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated api.v1.ApiTokenScope scopes = 2;
+   */
+  scopes: ApiTokenScope[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 75);
+
+/**
+ * @generated from message api.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"api.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: api.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message api.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 76);
+
+/**
+ * @generated from message api.v1.GetApiTokensRequest
+ */
+export type GetApiTokensRequest = Message<"api.v1.GetApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetApiTokensRequest.
+ * Use `create(GetApiTokensRequestSchema)` to create a new message.
+ */
+export const GetApiTokensRequestSchema: GenMessage<GetApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 77);
+
+/**
+ * @generated from message api.v1.GetApiTokensResponse
+ */
+export type GetApiTokensResponse = Message<"api.v1.GetApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message api.v1.GetApiTokensResponse.
+ * Use `create(GetApiTokensResponseSchema)` to create a new message.
+ */
+export const GetApiTokensResponseSchema: GenMessage<GetApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 78);
+
+/**
+ * @generated from message api.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"api.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message api.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 79);
+
+/**
+ * @generated from message api.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"api.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 80);
+
+/**
+ * @generated from message api.v1.ReleaseHistorySettings
+ */
+export type ReleaseHistorySettings = Message<"api.v1.ReleaseHistorySettings"> & {
+  /**
+   * @generated from field: optional int32 release_fetch_depth = 1;
+   */
+  releaseFetchDepth?: number;
+
+  /**
+   * @generated from field: optional int32 release_retention = 2;
+   */
+  releaseRetention?: number;
+
+  /**
+   * @generated from field: int32 default_release_fetch_depth = 3;
+   */
+  defaultReleaseFetchDepth: number;
+
+  /**
+   * @generated from field: int32 default_release_retention = 4;
+   */
+  defaultReleaseRetention: number;
+};
+
+/**
+ * Describes the message api.v1.ReleaseHistorySettings.
+ * Use `create(ReleaseHistorySettingsSchema)` to create a new message.
+ */
+export const ReleaseHistorySettingsSchema: GenMessage<ReleaseHistorySettings> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 81);
+
+/**
+ * @generated from message api.v1.GetReleaseHistorySettingsRequest
+ */
+export type GetReleaseHistorySettingsRequest = Message<"api.v1.GetReleaseHistorySettingsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetReleaseHistorySettingsRequest.
+ * Use `create(GetReleaseHistorySettingsRequestSchema)` to create a new message.
+ */
+export const GetReleaseHistorySettingsRequestSchema: GenMessage<GetReleaseHistorySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 82);
+
+/**
+ * @generated from message api.v1.GetReleaseHistorySettingsResponse
+ */
+export type GetReleaseHistorySettingsResponse = Message<"api.v1.GetReleaseHistorySettingsResponse"> & {
+  /**
+   * @generated from field: api.v1.ReleaseHistorySettings settings = 1;
+   */
+  settings?: ReleaseHistorySettings;
+};
+
+/**
+ * Describes the message api.v1.GetReleaseHistorySettingsResponse.
+ * Use `create(GetReleaseHistorySettingsResponseSchema)` to create a new message.
+ */
+export const GetReleaseHistorySettingsResponseSchema: GenMessage<GetReleaseHistorySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 83);
+
+/**
+ * @generated from message api.v1.UpdateReleaseHistorySettingsRequest
+ */
+export type UpdateReleaseHistorySettingsRequest = Message<"api.v1.UpdateReleaseHistorySettingsRequest"> & {
+  /**
+   * @generated from field: optional int32 release_fetch_depth = 1;
+   */
+  releaseFetchDepth?: number;
+
+  /**
+   * @generated from field: optional int32 release_retention = 2;
+   */
+  releaseRetention?: number;
+};
+
+/**
+ * Describes the message api.v1.UpdateReleaseHistorySettingsRequest.
+ * Use `create(UpdateReleaseHistorySettingsRequestSchema)` to create a new message.
+ */
+export const UpdateReleaseHistorySettingsRequestSchema: GenMessage<UpdateReleaseHistorySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 84);
+
+/**
+ * @generated from message api.v1.UpdateReleaseHistorySettingsResponse
+ */
+export type UpdateReleaseHistorySettingsResponse = Message<"api.v1.UpdateReleaseHistorySettingsResponse"> & {
+  /**
+   * @generated from field: api.v1.ReleaseHistorySettings settings = 1;
+   */
+  settings?: ReleaseHistorySettings;
+};
+
+/**
+ * Describes the message api.v1.UpdateReleaseHistorySettingsResponse.
+ * Use `create(UpdateReleaseHistorySettingsResponseSchema)` to create a new message.
+ */
+export const UpdateReleaseHistorySettingsResponseSchema: GenMessage<UpdateReleaseHistorySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 85);
+
+/**
+ * @generated from message api.v1.SearchResult
+ */
+export type SearchResult = Message<"api.v1.SearchResult"> & {
+  /**
+   * @generated from field: api.v1.TimelineEntry release = 1;
+   */
+  release?: TimelineEntry;
+
+  /**
+   * @generated from field: string snippet = 2;
+   */
+  snippet: string;
+};
+
+/**
+ * Describes the message api.v1.SearchResult.
+ * Use `create(SearchResultSchema)` to create a new message.
+ */
+export const SearchResultSchema: GenMessage<SearchResult> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 86);
+
+/**
+ * @generated from message api.v1.SearchReleasesRequest
+ */
+export type SearchReleasesRequest = Message<"api.v1.SearchReleasesRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: bool prerelease = 2;
+   */
+  prerelease: boolean;
+
+  /**
+   * @generated from field: optional int32 repository_id = 3;
+   */
+  repositoryId?: number;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp released_after = 4;
+   */
+  releasedAfter?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp released_before = 5;
+   */
+  releasedBefore?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.SearchReleasesRequest.
+ * Use `create(SearchReleasesRequestSchema)` to create a new message.
+ */
+export const SearchReleasesRequestSchema: GenMessage<SearchReleasesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 87);
+
+/**
+ * @generated from message api.v1.SearchReleasesResponse
+ */
+export type SearchReleasesResponse = Message<"api.v1.SearchReleasesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.SearchResult results = 1;
+   */
+  results: SearchResult[];
+};
+
+/**
+ * Describes the message api.v1.SearchReleasesResponse.
+ * Use `create(SearchReleasesResponseSchema)` to create a new message.
+ */
+export const SearchReleasesResponseSchema: GenMessage<SearchReleasesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 88);
+
+/**
+ * @generated from message api.v1.GetLoginProvidersRequest
+ */
+export type GetLoginProvidersRequest = Message<"api.v1.GetLoginProvidersRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetLoginProvidersRequest.
+ * Use `create(GetLoginProvidersRequestSchema)` to create a new message.
+ */
+export const GetLoginProvidersRequestSchema: GenMessage<GetLoginProvidersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 89);
+
+/**
+ * @generated from message api.v1.GetLoginProvidersResponse
+ */
+export type GetLoginProvidersResponse = Message<"api.v1.GetLoginProvidersResponse"> & {
+  /**
+   * @generated from field: bool oidc_enabled = 1;
+   */
+  oidcEnabled: boolean;
+
+  /**
+   * @generated from field: string oidc_name = 2;
+   */
+  oidcName: string;
+};
+
+/**
+ * Describes the message api.v1.GetLoginProvidersResponse.
+ * Use `create(GetLoginProvidersResponseSchema)` to create a new message.
+ */
+export const GetLoginProvidersResponseSchema: GenMessage<GetLoginProvidersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 90);
+
+/**
+ * @generated from message api.v1.RefreshTokenRequest
+ */
+export type RefreshTokenRequest = Message<"api.v1.RefreshTokenRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.RefreshTokenRequest.
+ * Use `create(RefreshTokenRequestSchema)` to create a new message.
+ */
+export const RefreshTokenRequestSchema: GenMessage<RefreshTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 91);
+
+/**
+ * @generated from message api.v1.RefreshTokenResponse
+ */
+export type RefreshTokenResponse = Message<"api.v1.RefreshTokenResponse"> & {
+  /**
+   * @generated from field: string access_token = 1;
+   */
+  accessToken: string;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp access_token_expires_at = 3;
+   */
+  accessTokenExpiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp refresh_token_expires_at = 4;
+   */
+  refreshTokenExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.RefreshTokenResponse.
+ * Use `create(RefreshTokenResponseSchema)` to create a new message.
+ */
+export const RefreshTokenResponseSchema: GenMessage<RefreshTokenResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_api, 92);
+
+/**
+ * @generated from enum api.v1.RepositoryStarType
+ */
+export enum RepositoryStarType {
+  /**
+   * @generated from enum value: STAR = 0;
+   */
+  STAR = 0,
+
+  /**
+   * @generated from enum value: WATCH = 1;
+   */
+  WATCH = 1,
+}
+
+/**
+ * Describes the enum api.v1.RepositoryStarType.
+ */
+export const RepositoryStarTypeSchema: GenEnum<RepositoryStarType> = /*@__PURE__*/
+  enumDesc(file_api_v1_api, 0);
+
+/**
+ * @generated from enum api.v1.DiffOperation
+ */
+export enum DiffOperation {
+  /**
+   * @generated from enum value: EQUAL = 0;
+   */
+  EQUAL = 0,
+
+  /**
+   * @generated from enum value: INSERT = 1;
+   */
+  INSERT = 1,
+
+  /**
+   * @generated from enum value: DELETE = 2;
+   */
+  DELETE = 2,
+}
+
+/**
+ * Describes the enum api.v1.DiffOperation.
+ */
+export const DiffOperationSchema: GenEnum<DiffOperation> = /*@__PURE__*/
+  enumDesc(file_api_v1_api, 1);
+
+/**
+ * @generated from enum api.v1.ApiTokenScope
+ */
+export enum ApiTokenScope {
+  /**
+   * @generated from enum value: READ = 0;
+   */
+  READ = 0,
+
+  /**
+   * @generated from enum value: WRITE = 1;
+   */
+  WRITE = 1,
+}
+
+/**
+ * Describes the enum api.v1.ApiTokenScope.
+ */
+export const ApiTokenScopeSchema: GenEnum<ApiTokenScope> = /*@__PURE__*/
+  enumDesc(file_api_v1_api, 2);
+
+/**
+ * @generated from service api.v1.ApiService
+ */
+export const ApiService: GenService<{
+  /**
+   * @generated from rpc api.v1.ApiService.Sync
+   */
+  sync: {
+    methodKind: "unary";
+    input: typeof SyncRequestSchema;
+    output: typeof SyncResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetRepositories
+   */
+  getRepositories: {
+    methodKind: "unary";
+    input: typeof GetRepositoriesRequestSchema;
+    output: typeof GetRepositoriesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.ToogleUserPublicFeed
+   */
+  toogleUserPublicFeed: {
+    methodKind: "unary";
+    input: typeof ToogleUserPublicFeedRequestSchema;
+    output: typeof ToogleUserPublicFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetMyUser
+   */
+  getMyUser: {
+    methodKind: "unary";
+    input: typeof GetMyUserRequestSchema;
+    output: typeof GetMyUserResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.ApiService.Logout
    */
@@ -492,6 +2178,254 @@ export const ApiService: GenService<{
     input: typeof ToggleUserOnboardedRequestSchema;
     output: typeof ToggleUserOnboardedResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.ApiService.MarkReleaseRead
+   */
+  markReleaseRead: {
+    methodKind: "unary";
+    input: typeof MarkReleaseReadRequestSchema;
+    output: typeof MarkReleaseReadResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.MarkRepositoryRead
+   */
+  markRepositoryRead: {
+    methodKind: "unary";
+    input: typeof MarkRepositoryReadRequestSchema;
+    output: typeof MarkRepositoryReadResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.MarkAllRead
+   */
+  markAllRead: {
+    methodKind: "unary";
+    input: typeof MarkAllReadRequestSchema;
+    output: typeof MarkAllReadResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetBookmarks
+   */
+  getBookmarks: {
+    methodKind: "unary";
+    input: typeof GetBookmarksRequestSchema;
+    output: typeof GetBookmarksResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.AddBookmark
+   */
+  addBookmark: {
+    methodKind: "unary";
+    input: typeof AddBookmarkRequestSchema;
+    output: typeof AddBookmarkResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RemoveBookmark
+   */
+  removeBookmark: {
+    methodKind: "unary";
+    input: typeof RemoveBookmarkRequestSchema;
+    output: typeof RemoveBookmarkResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.MuteRepository
+   */
+  muteRepository: {
+    methodKind: "unary";
+    input: typeof MuteRepositoryRequestSchema;
+    output: typeof MuteRepositoryResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.SnoozeRepository
+   */
+  snoozeRepository: {
+    methodKind: "unary";
+    input: typeof SnoozeRepositoryRequestSchema;
+    output: typeof SnoozeRepositoryResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetMutedRepositories
+   */
+  getMutedRepositories: {
+    methodKind: "unary";
+    input: typeof GetMutedRepositoriesRequestSchema;
+    output: typeof GetMutedRepositoriesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetGroups
+   */
+  getGroups: {
+    methodKind: "unary";
+    input: typeof GetGroupsRequestSchema;
+    output: typeof GetGroupsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.CreateGroup
+   */
+  createGroup: {
+    methodKind: "unary";
+    input: typeof CreateGroupRequestSchema;
+    output: typeof CreateGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RenameGroup
+   */
+  renameGroup: {
+    methodKind: "unary";
+    input: typeof RenameGroupRequestSchema;
+    output: typeof RenameGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.DeleteGroup
+   */
+  deleteGroup: {
+    methodKind: "unary";
+    input: typeof DeleteGroupRequestSchema;
+    output: typeof DeleteGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.AddRepositoryToGroup
+   */
+  addRepositoryToGroup: {
+    methodKind: "unary";
+    input: typeof AddRepositoryToGroupRequestSchema;
+    output: typeof AddRepositoryToGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RemoveRepositoryFromGroup
+   */
+  removeRepositoryFromGroup: {
+    methodKind: "unary";
+    input: typeof RemoveRepositoryFromGroupRequestSchema;
+    output: typeof RemoveRepositoryFromGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetLists
+   */
+  getLists: {
+    methodKind: "unary";
+    input: typeof GetListsRequestSchema;
+    output: typeof GetListsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.SearchReleases
+   */
+  searchReleases: {
+    methodKind: "unary";
+    input: typeof SearchReleasesRequestSchema;
+    output: typeof SearchReleasesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetRelease
+   */
+  getRelease: {
+    methodKind: "unary";
+    input: typeof GetReleaseRequestSchema;
+    output: typeof GetReleaseResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetReleaseDiff
+   */
+  getReleaseDiff: {
+    methodKind: "unary";
+    input: typeof GetReleaseDiffRequestSchema;
+    output: typeof GetReleaseDiffResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetReleaseHistorySettings
+   */
+  getReleaseHistorySettings: {
+    methodKind: "unary";
+    input: typeof GetReleaseHistorySettingsRequestSchema;
+    output: typeof GetReleaseHistorySettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.UpdateReleaseHistorySettings
+   */
+  updateReleaseHistorySettings: {
+    methodKind: "unary";
+    input: typeof UpdateReleaseHistorySettingsRequestSchema;
+    output: typeof UpdateReleaseHistorySettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetSessions
+   */
+  getSessions: {
+    methodKind: "unary";
+    input: typeof GetSessionsRequestSchema;
+    output: typeof GetSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RevokeAllSessions
+   */
+  revokeAllSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllSessionsRequestSchema;
+    output: typeof RevokeAllSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetApiTokens
+   */
+  getApiTokens: {
+    methodKind: "unary";
+    input: typeof GetApiTokensRequestSchema;
+    output: typeof GetApiTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.GetPrivateRepositorySettings
+   */
+  getPrivateRepositorySettings: {
+    methodKind: "unary";
+    input: typeof GetPrivateRepositorySettingsRequestSchema;
+    output: typeof GetPrivateRepositorySettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.UpdatePrivateRepositorySettings
+   */
+  updatePrivateRepositorySettings: {
+    methodKind: "unary";
+    input: typeof UpdatePrivateRepositorySettingsRequestSchema;
+    output: typeof UpdatePrivateRepositorySettingsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.DeleteMyAccount
+   */
+  deleteMyAccount: {
+    methodKind: "unary";
+    input: typeof DeleteMyAccountRequestSchema;
+    output: typeof DeleteMyAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ApiService.ExportMyData
+   */
+  exportMyData: {
+    methodKind: "unary";
+    input: typeof ExportMyDataRequestSchema;
+    output: typeof ExportMyDataResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_api, 0);
 
@@ -507,6 +2441,14 @@ export const AuthService: GenService<{
     input: typeof RefreshTokenRequestSchema;
     output: typeof RefreshTokenResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.AuthService.GetLoginProviders
+   */
+  getLoginProviders: {
+    methodKind: "unary";
+    input: typeof GetLoginProvidersRequestSchema;
+    output: typeof GetLoginProvidersResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_api, 1);
 
@@ -1,15 +1,22 @@
-import { useNavigate } from '@solidjs/router'
+import { useNavigate, useSearchParams } from '@solidjs/router'
 import { AiOutlineGithub } from 'solid-icons/ai'
 import { ImSpinner3 } from 'solid-icons/im'
-import { Component, createSignal, onMount, Show } from 'solid-js'
+import { Component, createResource, createSignal, onMount, Show } from 'solid-js'
 import { buttonVariants } from '~/components/ui/button'
 import { useState } from '~/context/state-context'
-import { isAuthenticated } from '~/services/auth-service'
+import { getLoginProviders, isAuthenticated } from '~/services/auth-service'
 
 const LoginPage: Component = () => {
 	const navigate = useNavigate()
+	const [searchParams] = useSearchParams()
 	const state = useState()
 	const [showGithubLogin, setShowGithubLogin] = createSignal(false)
+	const [loginProviders] = createResource(showGithubLogin, () => getLoginProviders())
+
+	const loginURL = (provider: string) =>
+		typeof searchParams.return_to === 'string'
+			? `/api/login/${provider}?return_to=${encodeURIComponent(searchParams.return_to)}`
+			: `/api/login/${provider}`
 
 	onMount(async () => {
 		if (await isAuthenticated()) {
@@ -30,9 +37,16 @@ const LoginPage: Component = () => {
 	return (
 		<div class="flex h-dvh justify-center items-center">
 			<Show when={showGithubLogin()} fallback={<ImSpinner3 class="animate-spin w-8 h-8" />}>
-				<a href={`/api/login/github`} class={buttonVariants({ variant: 'default' })}>
-					Login with GitHub <AiOutlineGithub />
-				</a>
+				<div class="flex flex-col gap-4">
+					<a href={loginURL('github')} class={buttonVariants({ variant: 'default' })}>
+						Login with GitHub <AiOutlineGithub />
+					</a>
+					<Show when={loginProviders()?.oidcEnabled}>
+						<a href={loginURL('oidc')} class={buttonVariants({ variant: 'outline' })}>
+							Login with {loginProviders()?.oidcName}
+						</a>
+					</Show>
+				</div>
 			</Show>
 		</div>
 	)
@@ -21,57 +21,83 @@ const OnboardingPage: ParentComponent = props => {
 const OnboardingStepOne: Component = () => {
 	const state = useState()
 	const [startSync, setStartSync] = createSignal(false)
+	const [needsGithubLink, setNeedsGithubLink] = createSignal(false)
 
 	const [releases, setReleases] = createResource(startSync, () => state.sync())
 
 	onMount(async () => {
-		await state.fetchUser()
+		const user = await state.fetchUser()
+
+		// Users that logged in with SSO have no stars to sync until they link their GitHub account
+		if (!user.githubLinked) {
+			setNeedsGithubLink(true)
+			return
+		}
+
 		setStartSync(true)
 	})
 
 	return (
-		<>
-			<CardHeader>
-				<CardTitle>Welcome to releases.one</CardTitle>
-				<CardDescription>
-					We are syncing your starred GitHub repositories. This may take a few seconds.
-				</CardDescription>
-			</CardHeader>
-			<CardContent class="grid gap-4">
-				<SolidSwitch>
-					<Match when={releases.state === 'ready'}>
-						<div class="flex gap-2 justify-center items-center">
-							<FiCheck class="w-4 h-4 justify-self-center" />
-							<span class="text-sm">Found {releases()?.repositoryCount} repositories</span>
-						</div>
-					</Match>
+		<Show
+			when={!needsGithubLink()}
+			fallback={
+				<>
+					<CardHeader>
+						<CardTitle>Welcome to releases.one</CardTitle>
+						<CardDescription>
+							Link your GitHub account, so we can sync the releases of your starred repositories.
+						</CardDescription>
+					</CardHeader>
+					<CardFooter class="flex gap-4 justify-center">
+						<a
+							class={buttonVariants({ variant: 'default' })}
+							href={`/api/login/github?link=true&return_to=${encodeURIComponent('/onboarding')}`}>
+							Link GitHub account
+						</a>
+					</CardFooter>
+				</>
+			}>
+				<CardHeader>
+					<CardTitle>Welcome to releases.one</CardTitle>
+					<CardDescription>
+						We are syncing your starred GitHub repositories. This may take a few seconds.
+					</CardDescription>
+				</CardHeader>
+				<CardContent class="grid gap-4">
+					<SolidSwitch>
+						<Match when={releases.state === 'ready'}>
+							<div class="flex gap-2 justify-center items-center">
+								<FiCheck class="w-4 h-4 justify-self-center" />
+								<span class="text-sm">Found {releases()?.repositoryCount} repositories</span>
+							</div>
+						</Match>
 
-					<Match when={releases.state === 'errored'}>
-						<div class="flex gap-2 justify-center items-center">
-							<FiAlertCircle class="w-4 h-4 justify-self-center" />
-							<span class="text-sm">Failed to fetch repositories</span>
-						</div>
-							<a
-								class={buttonVariants({ variant: 'default' })}
-								href="https://github.com/benjasper/releases.one/issues/new"
-								target="_blank">
-								Report issue
-							</a>
-					</Match>
+						<Match when={releases.state === 'errored'}>
+							<div class="flex gap-2 justify-center items-center">
+								<FiAlertCircle class="w-4 h-4 justify-self-center" />
+								<span class="text-sm">Failed to fetch repositories</span>
+							</div>
+								<a
+									class={buttonVariants({ variant: 'default' })}
+									href="https://github.com/benjasper/releases.one/issues/new"
+									target="_blank">
+									Report issue
+								</a>
+						</Match>
 
-					<Match when={true}>
-						<OcSync3 class="animate-spin w-8 h-8 justify-self-center" />
-					</Match>
-				</SolidSwitch>
-			</CardContent>
-			<Show when={releases.state === 'ready'}>
-				<CardFooter class="flex gap-4 justify-center">
-					<A class={buttonVariants({ variant: 'default' })} href="2">
-						Continue
-					</A>
-				</CardFooter>
-			</Show>
-		</>
+						<Match when={true}>
+							<OcSync3 class="animate-spin w-8 h-8 justify-self-center" />
+						</Match>
+					</SolidSwitch>
+				</CardContent>
+				<Show when={releases.state === 'ready'}>
+					<CardFooter class="flex gap-4 justify-center">
+						<A class={buttonVariants({ variant: 'default' })} href="2">
+							Continue
+						</A>
+					</CardFooter>
+				</Show>
+		</Show>
 	)
 }
 
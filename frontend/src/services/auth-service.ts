@@ -49,6 +49,10 @@ async function refreshToken(): Promise<boolean> {
 	return true
 }
 
+export async function getLoginProviders() {
+	return authService.getLoginProviders({})
+}
+
 export function logoutAndRemoveAuthData() {
 	localStorage.removeItem(ACCESS_TOKEN_EXPIRES_AT)
 }
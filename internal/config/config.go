@@ -1,16 +1,43 @@
 package config
 
-import "github.com/caarlos0/env/v11"
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
 
 type Config struct {
-	BaseURL                 string `env:"BASE_URL,required"`
-	IsProduction            bool   `env:"IS_PRODUCTION,required"`
-	JWTSecret               string `env:"JWT_SECRET,required"`
-	GithubClientID          string `env:"GITHUB_CLIENT_ID,required"`
-	GithubClientSecret      string `env:"GITHUB_CLIENT_SECRET,required"`
+	BaseURL      string `env:"BASE_URL,required"`
+	IsProduction bool   `env:"IS_PRODUCTION,required"`
+	// Used as the only HS256 signing key "default" when no JWT signing keys are configured, otherwise it only verifies tokens signed before
+	JWTSecret string `env:"JWT_SECRET"`
+	// Keys access and refresh tokens are signed with as "id:algorithm:base64key,...", the first one signs and the rest are only kept for verification
+	JWTSigningKeys     string `env:"JWT_SIGNING_KEYS"`
+	GithubClientID     string `env:"GITHUB_CLIENT_ID,required"`
+	GithubClientSecret string `env:"GITHUB_CLIENT_SECRET,required"`
+	// Login with an OpenID Connect provider is offered alongside GitHub when an issuer is set
+	OIDCIssuerURL    string `env:"OIDC_ISSUER_URL"`
+	OIDCClientID     string `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret string `env:"OIDC_CLIENT_SECRET"`
+	// Shown on the login button, e.g. the name of the company's identity provider
+	OIDCName                string `env:"OIDC_NAME" envDefault:"SSO"`
 	DatabaseURL             string `env:"DATABASE_URL,required"`
 	UserSyncInterval        int    `env:"USER_SYNC_INTERVAL,required"`
 	LoginSuccessRedirectURL string `env:"LOGIN_SUCCESS_REDIRECT_URL,required"`
+	// Origins a login may return to, in addition to the origin of the login success redirect
+	LoginReturnToOrigins []string `env:"LOGIN_RETURN_TO_ORIGINS" envSeparator:","`
+	// Keys GitHub tokens are encrypted with as "id:base64key,...", the first one encrypts and the rest are only kept for decryption
+	TokenEncryptionKeys string `env:"TOKEN_ENCRYPTION_KEYS,required"`
+	// How many of the newest releases are fetched per repository on every sync, users can override it
+	ReleaseFetchDepth int `env:"RELEASE_FETCH_DEPTH" envDefault:"3"`
+	// How many releases are kept per repository, users can override it
+	ReleaseRetention int `env:"RELEASE_RETENTION" envDefault:"10"`
+	// How many releases are paged through at most, when a repository published more than the fetch depth since the last sync
+	ReleaseCatchUpLimit int `env:"RELEASE_CATCH_UP_LIMIT" envDefault:"100"`
+	// How long a repository nobody follows anymore is kept, before it is deleted with its releases
+	GarbageCollectionGracePeriod time.Duration `env:"GC_GRACE_PERIOD" envDefault:"168h"`
+	// Only reports what the garbage collection would mark and delete, without changing anything
+	GarbageCollectionDryRun bool `env:"GC_DRY_RUN" envDefault:"false"`
 }
 
 func ParseConfig() (*Config, error) {
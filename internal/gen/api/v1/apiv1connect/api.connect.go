@@ -50,9 +50,93 @@ const (
 	// ApiServiceToggleUserOnboardedProcedure is the fully-qualified name of the ApiService's
 	// ToggleUserOnboarded RPC.
 	ApiServiceToggleUserOnboardedProcedure = "/api.v1.ApiService/ToggleUserOnboarded"
+	// ApiServiceMarkReleaseReadProcedure is the fully-qualified name of the ApiService's
+	// MarkReleaseRead RPC.
+	ApiServiceMarkReleaseReadProcedure = "/api.v1.ApiService/MarkReleaseRead"
+	// ApiServiceMarkRepositoryReadProcedure is the fully-qualified name of the ApiService's
+	// MarkRepositoryRead RPC.
+	ApiServiceMarkRepositoryReadProcedure = "/api.v1.ApiService/MarkRepositoryRead"
+	// ApiServiceMarkAllReadProcedure is the fully-qualified name of the ApiService's MarkAllRead RPC.
+	ApiServiceMarkAllReadProcedure = "/api.v1.ApiService/MarkAllRead"
+	// ApiServiceGetBookmarksProcedure is the fully-qualified name of the ApiService's GetBookmarks RPC.
+	ApiServiceGetBookmarksProcedure = "/api.v1.ApiService/GetBookmarks"
+	// ApiServiceAddBookmarkProcedure is the fully-qualified name of the ApiService's AddBookmark RPC.
+	ApiServiceAddBookmarkProcedure = "/api.v1.ApiService/AddBookmark"
+	// ApiServiceRemoveBookmarkProcedure is the fully-qualified name of the ApiService's RemoveBookmark
+	// RPC.
+	ApiServiceRemoveBookmarkProcedure = "/api.v1.ApiService/RemoveBookmark"
+	// ApiServiceMuteRepositoryProcedure is the fully-qualified name of the ApiService's MuteRepository
+	// RPC.
+	ApiServiceMuteRepositoryProcedure = "/api.v1.ApiService/MuteRepository"
+	// ApiServiceSnoozeRepositoryProcedure is the fully-qualified name of the ApiService's
+	// SnoozeRepository RPC.
+	ApiServiceSnoozeRepositoryProcedure = "/api.v1.ApiService/SnoozeRepository"
+	// ApiServiceGetMutedRepositoriesProcedure is the fully-qualified name of the ApiService's
+	// GetMutedRepositories RPC.
+	ApiServiceGetMutedRepositoriesProcedure = "/api.v1.ApiService/GetMutedRepositories"
+	// ApiServiceGetGroupsProcedure is the fully-qualified name of the ApiService's GetGroups RPC.
+	ApiServiceGetGroupsProcedure = "/api.v1.ApiService/GetGroups"
+	// ApiServiceCreateGroupProcedure is the fully-qualified name of the ApiService's CreateGroup RPC.
+	ApiServiceCreateGroupProcedure = "/api.v1.ApiService/CreateGroup"
+	// ApiServiceRenameGroupProcedure is the fully-qualified name of the ApiService's RenameGroup RPC.
+	ApiServiceRenameGroupProcedure = "/api.v1.ApiService/RenameGroup"
+	// ApiServiceDeleteGroupProcedure is the fully-qualified name of the ApiService's DeleteGroup RPC.
+	ApiServiceDeleteGroupProcedure = "/api.v1.ApiService/DeleteGroup"
+	// ApiServiceAddRepositoryToGroupProcedure is the fully-qualified name of the ApiService's
+	// AddRepositoryToGroup RPC.
+	ApiServiceAddRepositoryToGroupProcedure = "/api.v1.ApiService/AddRepositoryToGroup"
+	// ApiServiceRemoveRepositoryFromGroupProcedure is the fully-qualified name of the ApiService's
+	// RemoveRepositoryFromGroup RPC.
+	ApiServiceRemoveRepositoryFromGroupProcedure = "/api.v1.ApiService/RemoveRepositoryFromGroup"
+	// ApiServiceGetListsProcedure is the fully-qualified name of the ApiService's GetLists RPC.
+	ApiServiceGetListsProcedure = "/api.v1.ApiService/GetLists"
+	// ApiServiceSearchReleasesProcedure is the fully-qualified name of the ApiService's SearchReleases
+	// RPC.
+	ApiServiceSearchReleasesProcedure = "/api.v1.ApiService/SearchReleases"
+	// ApiServiceGetReleaseProcedure is the fully-qualified name of the ApiService's GetRelease RPC.
+	ApiServiceGetReleaseProcedure = "/api.v1.ApiService/GetRelease"
+	// ApiServiceGetReleaseDiffProcedure is the fully-qualified name of the ApiService's GetReleaseDiff
+	// RPC.
+	ApiServiceGetReleaseDiffProcedure = "/api.v1.ApiService/GetReleaseDiff"
+	// ApiServiceGetReleaseHistorySettingsProcedure is the fully-qualified name of the ApiService's
+	// GetReleaseHistorySettings RPC.
+	ApiServiceGetReleaseHistorySettingsProcedure = "/api.v1.ApiService/GetReleaseHistorySettings"
+	// ApiServiceUpdateReleaseHistorySettingsProcedure is the fully-qualified name of the ApiService's
+	// UpdateReleaseHistorySettings RPC.
+	ApiServiceUpdateReleaseHistorySettingsProcedure = "/api.v1.ApiService/UpdateReleaseHistorySettings"
+	// ApiServiceGetSessionsProcedure is the fully-qualified name of the ApiService's GetSessions RPC.
+	ApiServiceGetSessionsProcedure = "/api.v1.ApiService/GetSessions"
+	// ApiServiceRevokeSessionProcedure is the fully-qualified name of the ApiService's RevokeSession
+	// RPC.
+	ApiServiceRevokeSessionProcedure = "/api.v1.ApiService/RevokeSession"
+	// ApiServiceRevokeAllSessionsProcedure is the fully-qualified name of the ApiService's
+	// RevokeAllSessions RPC.
+	ApiServiceRevokeAllSessionsProcedure = "/api.v1.ApiService/RevokeAllSessions"
+	// ApiServiceCreateApiTokenProcedure is the fully-qualified name of the ApiService's CreateApiToken
+	// RPC.
+	ApiServiceCreateApiTokenProcedure = "/api.v1.ApiService/CreateApiToken"
+	// ApiServiceGetApiTokensProcedure is the fully-qualified name of the ApiService's GetApiTokens RPC.
+	ApiServiceGetApiTokensProcedure = "/api.v1.ApiService/GetApiTokens"
+	// ApiServiceRevokeApiTokenProcedure is the fully-qualified name of the ApiService's RevokeApiToken
+	// RPC.
+	ApiServiceRevokeApiTokenProcedure = "/api.v1.ApiService/RevokeApiToken"
+	// ApiServiceGetPrivateRepositorySettingsProcedure is the fully-qualified name of the ApiService's
+	// GetPrivateRepositorySettings RPC.
+	ApiServiceGetPrivateRepositorySettingsProcedure = "/api.v1.ApiService/GetPrivateRepositorySettings"
+	// ApiServiceUpdatePrivateRepositorySettingsProcedure is the fully-qualified name of the
+	// ApiService's UpdatePrivateRepositorySettings RPC.
+	ApiServiceUpdatePrivateRepositorySettingsProcedure = "/api.v1.ApiService/UpdatePrivateRepositorySettings"
+	// ApiServiceDeleteMyAccountProcedure is the fully-qualified name of the ApiService's
+	// DeleteMyAccount RPC.
+	ApiServiceDeleteMyAccountProcedure = "/api.v1.ApiService/DeleteMyAccount"
+	// ApiServiceExportMyDataProcedure is the fully-qualified name of the ApiService's ExportMyData RPC.
+	ApiServiceExportMyDataProcedure = "/api.v1.ApiService/ExportMyData"
 	// AuthServiceRefreshTokenProcedure is the fully-qualified name of the AuthService's RefreshToken
 	// RPC.
 	AuthServiceRefreshTokenProcedure = "/api.v1.AuthService/RefreshToken"
+	// AuthServiceGetLoginProvidersProcedure is the fully-qualified name of the AuthService's
+	// GetLoginProviders RPC.
+	AuthServiceGetLoginProvidersProcedure = "/api.v1.AuthService/GetLoginProviders"
 )
 
 // ApiServiceClient is a client for the api.v1.ApiService service.
@@ -63,6 +147,37 @@ type ApiServiceClient interface {
 	GetMyUser(context.Context, *connect.Request[v1.GetMyUserRequest]) (*connect.Response[v1.GetMyUserResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	ToggleUserOnboarded(context.Context, *connect.Request[v1.ToggleUserOnboardedRequest]) (*connect.Response[v1.ToggleUserOnboardedResponse], error)
+	MarkReleaseRead(context.Context, *connect.Request[v1.MarkReleaseReadRequest]) (*connect.Response[v1.MarkReleaseReadResponse], error)
+	MarkRepositoryRead(context.Context, *connect.Request[v1.MarkRepositoryReadRequest]) (*connect.Response[v1.MarkRepositoryReadResponse], error)
+	MarkAllRead(context.Context, *connect.Request[v1.MarkAllReadRequest]) (*connect.Response[v1.MarkAllReadResponse], error)
+	GetBookmarks(context.Context, *connect.Request[v1.GetBookmarksRequest]) (*connect.Response[v1.GetBookmarksResponse], error)
+	AddBookmark(context.Context, *connect.Request[v1.AddBookmarkRequest]) (*connect.Response[v1.AddBookmarkResponse], error)
+	RemoveBookmark(context.Context, *connect.Request[v1.RemoveBookmarkRequest]) (*connect.Response[v1.RemoveBookmarkResponse], error)
+	MuteRepository(context.Context, *connect.Request[v1.MuteRepositoryRequest]) (*connect.Response[v1.MuteRepositoryResponse], error)
+	SnoozeRepository(context.Context, *connect.Request[v1.SnoozeRepositoryRequest]) (*connect.Response[v1.SnoozeRepositoryResponse], error)
+	GetMutedRepositories(context.Context, *connect.Request[v1.GetMutedRepositoriesRequest]) (*connect.Response[v1.GetMutedRepositoriesResponse], error)
+	GetGroups(context.Context, *connect.Request[v1.GetGroupsRequest]) (*connect.Response[v1.GetGroupsResponse], error)
+	CreateGroup(context.Context, *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error)
+	RenameGroup(context.Context, *connect.Request[v1.RenameGroupRequest]) (*connect.Response[v1.RenameGroupResponse], error)
+	DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error)
+	AddRepositoryToGroup(context.Context, *connect.Request[v1.AddRepositoryToGroupRequest]) (*connect.Response[v1.AddRepositoryToGroupResponse], error)
+	RemoveRepositoryFromGroup(context.Context, *connect.Request[v1.RemoveRepositoryFromGroupRequest]) (*connect.Response[v1.RemoveRepositoryFromGroupResponse], error)
+	GetLists(context.Context, *connect.Request[v1.GetListsRequest]) (*connect.Response[v1.GetListsResponse], error)
+	SearchReleases(context.Context, *connect.Request[v1.SearchReleasesRequest]) (*connect.Response[v1.SearchReleasesResponse], error)
+	GetRelease(context.Context, *connect.Request[v1.GetReleaseRequest]) (*connect.Response[v1.GetReleaseResponse], error)
+	GetReleaseDiff(context.Context, *connect.Request[v1.GetReleaseDiffRequest]) (*connect.Response[v1.GetReleaseDiffResponse], error)
+	GetReleaseHistorySettings(context.Context, *connect.Request[v1.GetReleaseHistorySettingsRequest]) (*connect.Response[v1.GetReleaseHistorySettingsResponse], error)
+	UpdateReleaseHistorySettings(context.Context, *connect.Request[v1.UpdateReleaseHistorySettingsRequest]) (*connect.Response[v1.UpdateReleaseHistorySettingsResponse], error)
+	GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error)
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	GetApiTokens(context.Context, *connect.Request[v1.GetApiTokensRequest]) (*connect.Response[v1.GetApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+	GetPrivateRepositorySettings(context.Context, *connect.Request[v1.GetPrivateRepositorySettingsRequest]) (*connect.Response[v1.GetPrivateRepositorySettingsResponse], error)
+	UpdatePrivateRepositorySettings(context.Context, *connect.Request[v1.UpdatePrivateRepositorySettingsRequest]) (*connect.Response[v1.UpdatePrivateRepositorySettingsResponse], error)
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
 }
 
 // NewApiServiceClient constructs a client for the api.v1.ApiService service. By default, it uses
@@ -112,17 +227,234 @@ func NewApiServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(apiServiceMethods.ByName("ToggleUserOnboarded")),
 			connect.WithClientOptions(opts...),
 		),
+		markReleaseRead: connect.NewClient[v1.MarkReleaseReadRequest, v1.MarkReleaseReadResponse](
+			httpClient,
+			baseURL+ApiServiceMarkReleaseReadProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("MarkReleaseRead")),
+			connect.WithClientOptions(opts...),
+		),
+		markRepositoryRead: connect.NewClient[v1.MarkRepositoryReadRequest, v1.MarkRepositoryReadResponse](
+			httpClient,
+			baseURL+ApiServiceMarkRepositoryReadProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("MarkRepositoryRead")),
+			connect.WithClientOptions(opts...),
+		),
+		markAllRead: connect.NewClient[v1.MarkAllReadRequest, v1.MarkAllReadResponse](
+			httpClient,
+			baseURL+ApiServiceMarkAllReadProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("MarkAllRead")),
+			connect.WithClientOptions(opts...),
+		),
+		getBookmarks: connect.NewClient[v1.GetBookmarksRequest, v1.GetBookmarksResponse](
+			httpClient,
+			baseURL+ApiServiceGetBookmarksProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetBookmarks")),
+			connect.WithClientOptions(opts...),
+		),
+		addBookmark: connect.NewClient[v1.AddBookmarkRequest, v1.AddBookmarkResponse](
+			httpClient,
+			baseURL+ApiServiceAddBookmarkProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("AddBookmark")),
+			connect.WithClientOptions(opts...),
+		),
+		removeBookmark: connect.NewClient[v1.RemoveBookmarkRequest, v1.RemoveBookmarkResponse](
+			httpClient,
+			baseURL+ApiServiceRemoveBookmarkProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RemoveBookmark")),
+			connect.WithClientOptions(opts...),
+		),
+		muteRepository: connect.NewClient[v1.MuteRepositoryRequest, v1.MuteRepositoryResponse](
+			httpClient,
+			baseURL+ApiServiceMuteRepositoryProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("MuteRepository")),
+			connect.WithClientOptions(opts...),
+		),
+		snoozeRepository: connect.NewClient[v1.SnoozeRepositoryRequest, v1.SnoozeRepositoryResponse](
+			httpClient,
+			baseURL+ApiServiceSnoozeRepositoryProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("SnoozeRepository")),
+			connect.WithClientOptions(opts...),
+		),
+		getMutedRepositories: connect.NewClient[v1.GetMutedRepositoriesRequest, v1.GetMutedRepositoriesResponse](
+			httpClient,
+			baseURL+ApiServiceGetMutedRepositoriesProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetMutedRepositories")),
+			connect.WithClientOptions(opts...),
+		),
+		getGroups: connect.NewClient[v1.GetGroupsRequest, v1.GetGroupsResponse](
+			httpClient,
+			baseURL+ApiServiceGetGroupsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		createGroup: connect.NewClient[v1.CreateGroupRequest, v1.CreateGroupResponse](
+			httpClient,
+			baseURL+ApiServiceCreateGroupProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("CreateGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		renameGroup: connect.NewClient[v1.RenameGroupRequest, v1.RenameGroupResponse](
+			httpClient,
+			baseURL+ApiServiceRenameGroupProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RenameGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGroup: connect.NewClient[v1.DeleteGroupRequest, v1.DeleteGroupResponse](
+			httpClient,
+			baseURL+ApiServiceDeleteGroupProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("DeleteGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		addRepositoryToGroup: connect.NewClient[v1.AddRepositoryToGroupRequest, v1.AddRepositoryToGroupResponse](
+			httpClient,
+			baseURL+ApiServiceAddRepositoryToGroupProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("AddRepositoryToGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		removeRepositoryFromGroup: connect.NewClient[v1.RemoveRepositoryFromGroupRequest, v1.RemoveRepositoryFromGroupResponse](
+			httpClient,
+			baseURL+ApiServiceRemoveRepositoryFromGroupProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RemoveRepositoryFromGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		getLists: connect.NewClient[v1.GetListsRequest, v1.GetListsResponse](
+			httpClient,
+			baseURL+ApiServiceGetListsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetLists")),
+			connect.WithClientOptions(opts...),
+		),
+		searchReleases: connect.NewClient[v1.SearchReleasesRequest, v1.SearchReleasesResponse](
+			httpClient,
+			baseURL+ApiServiceSearchReleasesProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("SearchReleases")),
+			connect.WithClientOptions(opts...),
+		),
+		getRelease: connect.NewClient[v1.GetReleaseRequest, v1.GetReleaseResponse](
+			httpClient,
+			baseURL+ApiServiceGetReleaseProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetRelease")),
+			connect.WithClientOptions(opts...),
+		),
+		getReleaseDiff: connect.NewClient[v1.GetReleaseDiffRequest, v1.GetReleaseDiffResponse](
+			httpClient,
+			baseURL+ApiServiceGetReleaseDiffProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetReleaseDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		getReleaseHistorySettings: connect.NewClient[v1.GetReleaseHistorySettingsRequest, v1.GetReleaseHistorySettingsResponse](
+			httpClient,
+			baseURL+ApiServiceGetReleaseHistorySettingsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetReleaseHistorySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateReleaseHistorySettings: connect.NewClient[v1.UpdateReleaseHistorySettingsRequest, v1.UpdateReleaseHistorySettingsResponse](
+			httpClient,
+			baseURL+ApiServiceUpdateReleaseHistorySettingsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("UpdateReleaseHistorySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getSessions: connect.NewClient[v1.GetSessionsRequest, v1.GetSessionsResponse](
+			httpClient,
+			baseURL+ApiServiceGetSessionsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+ApiServiceRevokeSessionProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllSessions: connect.NewClient[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse](
+			httpClient,
+			baseURL+ApiServiceRevokeAllSessionsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RevokeAllSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		createApiToken: connect.NewClient[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse](
+			httpClient,
+			baseURL+ApiServiceCreateApiTokenProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("CreateApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getApiTokens: connect.NewClient[v1.GetApiTokensRequest, v1.GetApiTokensResponse](
+			httpClient,
+			baseURL+ApiServiceGetApiTokensProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetApiTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiToken: connect.NewClient[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse](
+			httpClient,
+			baseURL+ApiServiceRevokeApiTokenProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("RevokeApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getPrivateRepositorySettings: connect.NewClient[v1.GetPrivateRepositorySettingsRequest, v1.GetPrivateRepositorySettingsResponse](
+			httpClient,
+			baseURL+ApiServiceGetPrivateRepositorySettingsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("GetPrivateRepositorySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePrivateRepositorySettings: connect.NewClient[v1.UpdatePrivateRepositorySettingsRequest, v1.UpdatePrivateRepositorySettingsResponse](
+			httpClient,
+			baseURL+ApiServiceUpdatePrivateRepositorySettingsProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("UpdatePrivateRepositorySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMyAccount: connect.NewClient[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse](
+			httpClient,
+			baseURL+ApiServiceDeleteMyAccountProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("DeleteMyAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		exportMyData: connect.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+ApiServiceExportMyDataProcedure,
+			connect.WithSchema(apiServiceMethods.ByName("ExportMyData")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // apiServiceClient implements ApiServiceClient.
 type apiServiceClient struct {
-	sync                 *connect.Client[v1.SyncRequest, v1.SyncResponse]
-	getRepositories      *connect.Client[v1.GetRepositoriesRequest, v1.GetRepositoriesResponse]
-	toogleUserPublicFeed *connect.Client[v1.ToogleUserPublicFeedRequest, v1.ToogleUserPublicFeedResponse]
-	getMyUser            *connect.Client[v1.GetMyUserRequest, v1.GetMyUserResponse]
-	logout               *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
-	toggleUserOnboarded  *connect.Client[v1.ToggleUserOnboardedRequest, v1.ToggleUserOnboardedResponse]
+	sync                            *connect.Client[v1.SyncRequest, v1.SyncResponse]
+	getRepositories                 *connect.Client[v1.GetRepositoriesRequest, v1.GetRepositoriesResponse]
+	toogleUserPublicFeed            *connect.Client[v1.ToogleUserPublicFeedRequest, v1.ToogleUserPublicFeedResponse]
+	getMyUser                       *connect.Client[v1.GetMyUserRequest, v1.GetMyUserResponse]
+	logout                          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	toggleUserOnboarded             *connect.Client[v1.ToggleUserOnboardedRequest, v1.ToggleUserOnboardedResponse]
+	markReleaseRead                 *connect.Client[v1.MarkReleaseReadRequest, v1.MarkReleaseReadResponse]
+	markRepositoryRead              *connect.Client[v1.MarkRepositoryReadRequest, v1.MarkRepositoryReadResponse]
+	markAllRead                     *connect.Client[v1.MarkAllReadRequest, v1.MarkAllReadResponse]
+	getBookmarks                    *connect.Client[v1.GetBookmarksRequest, v1.GetBookmarksResponse]
+	addBookmark                     *connect.Client[v1.AddBookmarkRequest, v1.AddBookmarkResponse]
+	removeBookmark                  *connect.Client[v1.RemoveBookmarkRequest, v1.RemoveBookmarkResponse]
+	muteRepository                  *connect.Client[v1.MuteRepositoryRequest, v1.MuteRepositoryResponse]
+	snoozeRepository                *connect.Client[v1.SnoozeRepositoryRequest, v1.SnoozeRepositoryResponse]
+	getMutedRepositories            *connect.Client[v1.GetMutedRepositoriesRequest, v1.GetMutedRepositoriesResponse]
+	getGroups                       *connect.Client[v1.GetGroupsRequest, v1.GetGroupsResponse]
+	createGroup                     *connect.Client[v1.CreateGroupRequest, v1.CreateGroupResponse]
+	renameGroup                     *connect.Client[v1.RenameGroupRequest, v1.RenameGroupResponse]
+	deleteGroup                     *connect.Client[v1.DeleteGroupRequest, v1.DeleteGroupResponse]
+	addRepositoryToGroup            *connect.Client[v1.AddRepositoryToGroupRequest, v1.AddRepositoryToGroupResponse]
+	removeRepositoryFromGroup       *connect.Client[v1.RemoveRepositoryFromGroupRequest, v1.RemoveRepositoryFromGroupResponse]
+	getLists                        *connect.Client[v1.GetListsRequest, v1.GetListsResponse]
+	searchReleases                  *connect.Client[v1.SearchReleasesRequest, v1.SearchReleasesResponse]
+	getRelease                      *connect.Client[v1.GetReleaseRequest, v1.GetReleaseResponse]
+	getReleaseDiff                  *connect.Client[v1.GetReleaseDiffRequest, v1.GetReleaseDiffResponse]
+	getReleaseHistorySettings       *connect.Client[v1.GetReleaseHistorySettingsRequest, v1.GetReleaseHistorySettingsResponse]
+	updateReleaseHistorySettings    *connect.Client[v1.UpdateReleaseHistorySettingsRequest, v1.UpdateReleaseHistorySettingsResponse]
+	getSessions                     *connect.Client[v1.GetSessionsRequest, v1.GetSessionsResponse]
+	revokeSession                   *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeAllSessions               *connect.Client[v1.RevokeAllSessionsRequest, v1.RevokeAllSessionsResponse]
+	createApiToken                  *connect.Client[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse]
+	getApiTokens                    *connect.Client[v1.GetApiTokensRequest, v1.GetApiTokensResponse]
+	revokeApiToken                  *connect.Client[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse]
+	getPrivateRepositorySettings    *connect.Client[v1.GetPrivateRepositorySettingsRequest, v1.GetPrivateRepositorySettingsResponse]
+	updatePrivateRepositorySettings *connect.Client[v1.UpdatePrivateRepositorySettingsRequest, v1.UpdatePrivateRepositorySettingsResponse]
+	deleteMyAccount                 *connect.Client[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse]
+	exportMyData                    *connect.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
 }
 
 // Sync calls api.v1.ApiService.Sync.
@@ -155,6 +487,161 @@ func (c *apiServiceClient) ToggleUserOnboarded(ctx context.Context, req *connect
 	return c.toggleUserOnboarded.CallUnary(ctx, req)
 }
 
+// MarkReleaseRead calls api.v1.ApiService.MarkReleaseRead.
+func (c *apiServiceClient) MarkReleaseRead(ctx context.Context, req *connect.Request[v1.MarkReleaseReadRequest]) (*connect.Response[v1.MarkReleaseReadResponse], error) {
+	return c.markReleaseRead.CallUnary(ctx, req)
+}
+
+// MarkRepositoryRead calls api.v1.ApiService.MarkRepositoryRead.
+func (c *apiServiceClient) MarkRepositoryRead(ctx context.Context, req *connect.Request[v1.MarkRepositoryReadRequest]) (*connect.Response[v1.MarkRepositoryReadResponse], error) {
+	return c.markRepositoryRead.CallUnary(ctx, req)
+}
+
+// MarkAllRead calls api.v1.ApiService.MarkAllRead.
+func (c *apiServiceClient) MarkAllRead(ctx context.Context, req *connect.Request[v1.MarkAllReadRequest]) (*connect.Response[v1.MarkAllReadResponse], error) {
+	return c.markAllRead.CallUnary(ctx, req)
+}
+
+// GetBookmarks calls api.v1.ApiService.GetBookmarks.
+func (c *apiServiceClient) GetBookmarks(ctx context.Context, req *connect.Request[v1.GetBookmarksRequest]) (*connect.Response[v1.GetBookmarksResponse], error) {
+	return c.getBookmarks.CallUnary(ctx, req)
+}
+
+// AddBookmark calls api.v1.ApiService.AddBookmark.
+func (c *apiServiceClient) AddBookmark(ctx context.Context, req *connect.Request[v1.AddBookmarkRequest]) (*connect.Response[v1.AddBookmarkResponse], error) {
+	return c.addBookmark.CallUnary(ctx, req)
+}
+
+// RemoveBookmark calls api.v1.ApiService.RemoveBookmark.
+func (c *apiServiceClient) RemoveBookmark(ctx context.Context, req *connect.Request[v1.RemoveBookmarkRequest]) (*connect.Response[v1.RemoveBookmarkResponse], error) {
+	return c.removeBookmark.CallUnary(ctx, req)
+}
+
+// MuteRepository calls api.v1.ApiService.MuteRepository.
+func (c *apiServiceClient) MuteRepository(ctx context.Context, req *connect.Request[v1.MuteRepositoryRequest]) (*connect.Response[v1.MuteRepositoryResponse], error) {
+	return c.muteRepository.CallUnary(ctx, req)
+}
+
+// SnoozeRepository calls api.v1.ApiService.SnoozeRepository.
+func (c *apiServiceClient) SnoozeRepository(ctx context.Context, req *connect.Request[v1.SnoozeRepositoryRequest]) (*connect.Response[v1.SnoozeRepositoryResponse], error) {
+	return c.snoozeRepository.CallUnary(ctx, req)
+}
+
+// GetMutedRepositories calls api.v1.ApiService.GetMutedRepositories.
+func (c *apiServiceClient) GetMutedRepositories(ctx context.Context, req *connect.Request[v1.GetMutedRepositoriesRequest]) (*connect.Response[v1.GetMutedRepositoriesResponse], error) {
+	return c.getMutedRepositories.CallUnary(ctx, req)
+}
+
+// GetGroups calls api.v1.ApiService.GetGroups.
+func (c *apiServiceClient) GetGroups(ctx context.Context, req *connect.Request[v1.GetGroupsRequest]) (*connect.Response[v1.GetGroupsResponse], error) {
+	return c.getGroups.CallUnary(ctx, req)
+}
+
+// CreateGroup calls api.v1.ApiService.CreateGroup.
+func (c *apiServiceClient) CreateGroup(ctx context.Context, req *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error) {
+	return c.createGroup.CallUnary(ctx, req)
+}
+
+// RenameGroup calls api.v1.ApiService.RenameGroup.
+func (c *apiServiceClient) RenameGroup(ctx context.Context, req *connect.Request[v1.RenameGroupRequest]) (*connect.Response[v1.RenameGroupResponse], error) {
+	return c.renameGroup.CallUnary(ctx, req)
+}
+
+// DeleteGroup calls api.v1.ApiService.DeleteGroup.
+func (c *apiServiceClient) DeleteGroup(ctx context.Context, req *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error) {
+	return c.deleteGroup.CallUnary(ctx, req)
+}
+
+// AddRepositoryToGroup calls api.v1.ApiService.AddRepositoryToGroup.
+func (c *apiServiceClient) AddRepositoryToGroup(ctx context.Context, req *connect.Request[v1.AddRepositoryToGroupRequest]) (*connect.Response[v1.AddRepositoryToGroupResponse], error) {
+	return c.addRepositoryToGroup.CallUnary(ctx, req)
+}
+
+// RemoveRepositoryFromGroup calls api.v1.ApiService.RemoveRepositoryFromGroup.
+func (c *apiServiceClient) RemoveRepositoryFromGroup(ctx context.Context, req *connect.Request[v1.RemoveRepositoryFromGroupRequest]) (*connect.Response[v1.RemoveRepositoryFromGroupResponse], error) {
+	return c.removeRepositoryFromGroup.CallUnary(ctx, req)
+}
+
+// GetLists calls api.v1.ApiService.GetLists.
+func (c *apiServiceClient) GetLists(ctx context.Context, req *connect.Request[v1.GetListsRequest]) (*connect.Response[v1.GetListsResponse], error) {
+	return c.getLists.CallUnary(ctx, req)
+}
+
+// SearchReleases calls api.v1.ApiService.SearchReleases.
+func (c *apiServiceClient) SearchReleases(ctx context.Context, req *connect.Request[v1.SearchReleasesRequest]) (*connect.Response[v1.SearchReleasesResponse], error) {
+	return c.searchReleases.CallUnary(ctx, req)
+}
+
+// GetRelease calls api.v1.ApiService.GetRelease.
+func (c *apiServiceClient) GetRelease(ctx context.Context, req *connect.Request[v1.GetReleaseRequest]) (*connect.Response[v1.GetReleaseResponse], error) {
+	return c.getRelease.CallUnary(ctx, req)
+}
+
+// GetReleaseDiff calls api.v1.ApiService.GetReleaseDiff.
+func (c *apiServiceClient) GetReleaseDiff(ctx context.Context, req *connect.Request[v1.GetReleaseDiffRequest]) (*connect.Response[v1.GetReleaseDiffResponse], error) {
+	return c.getReleaseDiff.CallUnary(ctx, req)
+}
+
+// GetReleaseHistorySettings calls api.v1.ApiService.GetReleaseHistorySettings.
+func (c *apiServiceClient) GetReleaseHistorySettings(ctx context.Context, req *connect.Request[v1.GetReleaseHistorySettingsRequest]) (*connect.Response[v1.GetReleaseHistorySettingsResponse], error) {
+	return c.getReleaseHistorySettings.CallUnary(ctx, req)
+}
+
+// UpdateReleaseHistorySettings calls api.v1.ApiService.UpdateReleaseHistorySettings.
+func (c *apiServiceClient) UpdateReleaseHistorySettings(ctx context.Context, req *connect.Request[v1.UpdateReleaseHistorySettingsRequest]) (*connect.Response[v1.UpdateReleaseHistorySettingsResponse], error) {
+	return c.updateReleaseHistorySettings.CallUnary(ctx, req)
+}
+
+// GetSessions calls api.v1.ApiService.GetSessions.
+func (c *apiServiceClient) GetSessions(ctx context.Context, req *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error) {
+	return c.getSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls api.v1.ApiService.RevokeSession.
+func (c *apiServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeAllSessions calls api.v1.ApiService.RevokeAllSessions.
+func (c *apiServiceClient) RevokeAllSessions(ctx context.Context, req *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error) {
+	return c.revokeAllSessions.CallUnary(ctx, req)
+}
+
+// CreateApiToken calls api.v1.ApiService.CreateApiToken.
+func (c *apiServiceClient) CreateApiToken(ctx context.Context, req *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// GetApiTokens calls api.v1.ApiService.GetApiTokens.
+func (c *apiServiceClient) GetApiTokens(ctx context.Context, req *connect.Request[v1.GetApiTokensRequest]) (*connect.Response[v1.GetApiTokensResponse], error) {
+	return c.getApiTokens.CallUnary(ctx, req)
+}
+
+// RevokeApiToken calls api.v1.ApiService.RevokeApiToken.
+func (c *apiServiceClient) RevokeApiToken(ctx context.Context, req *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return c.revokeApiToken.CallUnary(ctx, req)
+}
+
+// GetPrivateRepositorySettings calls api.v1.ApiService.GetPrivateRepositorySettings.
+func (c *apiServiceClient) GetPrivateRepositorySettings(ctx context.Context, req *connect.Request[v1.GetPrivateRepositorySettingsRequest]) (*connect.Response[v1.GetPrivateRepositorySettingsResponse], error) {
+	return c.getPrivateRepositorySettings.CallUnary(ctx, req)
+}
+
+// UpdatePrivateRepositorySettings calls api.v1.ApiService.UpdatePrivateRepositorySettings.
+func (c *apiServiceClient) UpdatePrivateRepositorySettings(ctx context.Context, req *connect.Request[v1.UpdatePrivateRepositorySettingsRequest]) (*connect.Response[v1.UpdatePrivateRepositorySettingsResponse], error) {
+	return c.updatePrivateRepositorySettings.CallUnary(ctx, req)
+}
+
+// DeleteMyAccount calls api.v1.ApiService.DeleteMyAccount.
+func (c *apiServiceClient) DeleteMyAccount(ctx context.Context, req *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return c.deleteMyAccount.CallUnary(ctx, req)
+}
+
+// ExportMyData calls api.v1.ApiService.ExportMyData.
+func (c *apiServiceClient) ExportMyData(ctx context.Context, req *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return c.exportMyData.CallUnary(ctx, req)
+}
+
 // ApiServiceHandler is an implementation of the api.v1.ApiService service.
 type ApiServiceHandler interface {
 	Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error)
@@ -163,6 +650,37 @@ type ApiServiceHandler interface {
 	GetMyUser(context.Context, *connect.Request[v1.GetMyUserRequest]) (*connect.Response[v1.GetMyUserResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	ToggleUserOnboarded(context.Context, *connect.Request[v1.ToggleUserOnboardedRequest]) (*connect.Response[v1.ToggleUserOnboardedResponse], error)
+	MarkReleaseRead(context.Context, *connect.Request[v1.MarkReleaseReadRequest]) (*connect.Response[v1.MarkReleaseReadResponse], error)
+	MarkRepositoryRead(context.Context, *connect.Request[v1.MarkRepositoryReadRequest]) (*connect.Response[v1.MarkRepositoryReadResponse], error)
+	MarkAllRead(context.Context, *connect.Request[v1.MarkAllReadRequest]) (*connect.Response[v1.MarkAllReadResponse], error)
+	GetBookmarks(context.Context, *connect.Request[v1.GetBookmarksRequest]) (*connect.Response[v1.GetBookmarksResponse], error)
+	AddBookmark(context.Context, *connect.Request[v1.AddBookmarkRequest]) (*connect.Response[v1.AddBookmarkResponse], error)
+	RemoveBookmark(context.Context, *connect.Request[v1.RemoveBookmarkRequest]) (*connect.Response[v1.RemoveBookmarkResponse], error)
+	MuteRepository(context.Context, *connect.Request[v1.MuteRepositoryRequest]) (*connect.Response[v1.MuteRepositoryResponse], error)
+	SnoozeRepository(context.Context, *connect.Request[v1.SnoozeRepositoryRequest]) (*connect.Response[v1.SnoozeRepositoryResponse], error)
+	GetMutedRepositories(context.Context, *connect.Request[v1.GetMutedRepositoriesRequest]) (*connect.Response[v1.GetMutedRepositoriesResponse], error)
+	GetGroups(context.Context, *connect.Request[v1.GetGroupsRequest]) (*connect.Response[v1.GetGroupsResponse], error)
+	CreateGroup(context.Context, *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error)
+	RenameGroup(context.Context, *connect.Request[v1.RenameGroupRequest]) (*connect.Response[v1.RenameGroupResponse], error)
+	DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error)
+	AddRepositoryToGroup(context.Context, *connect.Request[v1.AddRepositoryToGroupRequest]) (*connect.Response[v1.AddRepositoryToGroupResponse], error)
+	RemoveRepositoryFromGroup(context.Context, *connect.Request[v1.RemoveRepositoryFromGroupRequest]) (*connect.Response[v1.RemoveRepositoryFromGroupResponse], error)
+	GetLists(context.Context, *connect.Request[v1.GetListsRequest]) (*connect.Response[v1.GetListsResponse], error)
+	SearchReleases(context.Context, *connect.Request[v1.SearchReleasesRequest]) (*connect.Response[v1.SearchReleasesResponse], error)
+	GetRelease(context.Context, *connect.Request[v1.GetReleaseRequest]) (*connect.Response[v1.GetReleaseResponse], error)
+	GetReleaseDiff(context.Context, *connect.Request[v1.GetReleaseDiffRequest]) (*connect.Response[v1.GetReleaseDiffResponse], error)
+	GetReleaseHistorySettings(context.Context, *connect.Request[v1.GetReleaseHistorySettingsRequest]) (*connect.Response[v1.GetReleaseHistorySettingsResponse], error)
+	UpdateReleaseHistorySettings(context.Context, *connect.Request[v1.UpdateReleaseHistorySettingsRequest]) (*connect.Response[v1.UpdateReleaseHistorySettingsResponse], error)
+	GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error)
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	GetApiTokens(context.Context, *connect.Request[v1.GetApiTokensRequest]) (*connect.Response[v1.GetApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+	GetPrivateRepositorySettings(context.Context, *connect.Request[v1.GetPrivateRepositorySettingsRequest]) (*connect.Response[v1.GetPrivateRepositorySettingsResponse], error)
+	UpdatePrivateRepositorySettings(context.Context, *connect.Request[v1.UpdatePrivateRepositorySettingsRequest]) (*connect.Response[v1.UpdatePrivateRepositorySettingsResponse], error)
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
 }
 
 // NewApiServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -208,6 +726,192 @@ func NewApiServiceHandler(svc ApiServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(apiServiceMethods.ByName("ToggleUserOnboarded")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiServiceMarkReleaseReadHandler := connect.NewUnaryHandler(
+		ApiServiceMarkReleaseReadProcedure,
+		svc.MarkReleaseRead,
+		connect.WithSchema(apiServiceMethods.ByName("MarkReleaseRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceMarkRepositoryReadHandler := connect.NewUnaryHandler(
+		ApiServiceMarkRepositoryReadProcedure,
+		svc.MarkRepositoryRead,
+		connect.WithSchema(apiServiceMethods.ByName("MarkRepositoryRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceMarkAllReadHandler := connect.NewUnaryHandler(
+		ApiServiceMarkAllReadProcedure,
+		svc.MarkAllRead,
+		connect.WithSchema(apiServiceMethods.ByName("MarkAllRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetBookmarksHandler := connect.NewUnaryHandler(
+		ApiServiceGetBookmarksProcedure,
+		svc.GetBookmarks,
+		connect.WithSchema(apiServiceMethods.ByName("GetBookmarks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceAddBookmarkHandler := connect.NewUnaryHandler(
+		ApiServiceAddBookmarkProcedure,
+		svc.AddBookmark,
+		connect.WithSchema(apiServiceMethods.ByName("AddBookmark")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRemoveBookmarkHandler := connect.NewUnaryHandler(
+		ApiServiceRemoveBookmarkProcedure,
+		svc.RemoveBookmark,
+		connect.WithSchema(apiServiceMethods.ByName("RemoveBookmark")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceMuteRepositoryHandler := connect.NewUnaryHandler(
+		ApiServiceMuteRepositoryProcedure,
+		svc.MuteRepository,
+		connect.WithSchema(apiServiceMethods.ByName("MuteRepository")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceSnoozeRepositoryHandler := connect.NewUnaryHandler(
+		ApiServiceSnoozeRepositoryProcedure,
+		svc.SnoozeRepository,
+		connect.WithSchema(apiServiceMethods.ByName("SnoozeRepository")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetMutedRepositoriesHandler := connect.NewUnaryHandler(
+		ApiServiceGetMutedRepositoriesProcedure,
+		svc.GetMutedRepositories,
+		connect.WithSchema(apiServiceMethods.ByName("GetMutedRepositories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetGroupsHandler := connect.NewUnaryHandler(
+		ApiServiceGetGroupsProcedure,
+		svc.GetGroups,
+		connect.WithSchema(apiServiceMethods.ByName("GetGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceCreateGroupHandler := connect.NewUnaryHandler(
+		ApiServiceCreateGroupProcedure,
+		svc.CreateGroup,
+		connect.WithSchema(apiServiceMethods.ByName("CreateGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRenameGroupHandler := connect.NewUnaryHandler(
+		ApiServiceRenameGroupProcedure,
+		svc.RenameGroup,
+		connect.WithSchema(apiServiceMethods.ByName("RenameGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceDeleteGroupHandler := connect.NewUnaryHandler(
+		ApiServiceDeleteGroupProcedure,
+		svc.DeleteGroup,
+		connect.WithSchema(apiServiceMethods.ByName("DeleteGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceAddRepositoryToGroupHandler := connect.NewUnaryHandler(
+		ApiServiceAddRepositoryToGroupProcedure,
+		svc.AddRepositoryToGroup,
+		connect.WithSchema(apiServiceMethods.ByName("AddRepositoryToGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRemoveRepositoryFromGroupHandler := connect.NewUnaryHandler(
+		ApiServiceRemoveRepositoryFromGroupProcedure,
+		svc.RemoveRepositoryFromGroup,
+		connect.WithSchema(apiServiceMethods.ByName("RemoveRepositoryFromGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetListsHandler := connect.NewUnaryHandler(
+		ApiServiceGetListsProcedure,
+		svc.GetLists,
+		connect.WithSchema(apiServiceMethods.ByName("GetLists")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceSearchReleasesHandler := connect.NewUnaryHandler(
+		ApiServiceSearchReleasesProcedure,
+		svc.SearchReleases,
+		connect.WithSchema(apiServiceMethods.ByName("SearchReleases")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetReleaseHandler := connect.NewUnaryHandler(
+		ApiServiceGetReleaseProcedure,
+		svc.GetRelease,
+		connect.WithSchema(apiServiceMethods.ByName("GetRelease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetReleaseDiffHandler := connect.NewUnaryHandler(
+		ApiServiceGetReleaseDiffProcedure,
+		svc.GetReleaseDiff,
+		connect.WithSchema(apiServiceMethods.ByName("GetReleaseDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetReleaseHistorySettingsHandler := connect.NewUnaryHandler(
+		ApiServiceGetReleaseHistorySettingsProcedure,
+		svc.GetReleaseHistorySettings,
+		connect.WithSchema(apiServiceMethods.ByName("GetReleaseHistorySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceUpdateReleaseHistorySettingsHandler := connect.NewUnaryHandler(
+		ApiServiceUpdateReleaseHistorySettingsProcedure,
+		svc.UpdateReleaseHistorySettings,
+		connect.WithSchema(apiServiceMethods.ByName("UpdateReleaseHistorySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetSessionsHandler := connect.NewUnaryHandler(
+		ApiServiceGetSessionsProcedure,
+		svc.GetSessions,
+		connect.WithSchema(apiServiceMethods.ByName("GetSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		ApiServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(apiServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRevokeAllSessionsHandler := connect.NewUnaryHandler(
+		ApiServiceRevokeAllSessionsProcedure,
+		svc.RevokeAllSessions,
+		connect.WithSchema(apiServiceMethods.ByName("RevokeAllSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceCreateApiTokenHandler := connect.NewUnaryHandler(
+		ApiServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(apiServiceMethods.ByName("CreateApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetApiTokensHandler := connect.NewUnaryHandler(
+		ApiServiceGetApiTokensProcedure,
+		svc.GetApiTokens,
+		connect.WithSchema(apiServiceMethods.ByName("GetApiTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceRevokeApiTokenHandler := connect.NewUnaryHandler(
+		ApiServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		connect.WithSchema(apiServiceMethods.ByName("RevokeApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceGetPrivateRepositorySettingsHandler := connect.NewUnaryHandler(
+		ApiServiceGetPrivateRepositorySettingsProcedure,
+		svc.GetPrivateRepositorySettings,
+		connect.WithSchema(apiServiceMethods.ByName("GetPrivateRepositorySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceUpdatePrivateRepositorySettingsHandler := connect.NewUnaryHandler(
+		ApiServiceUpdatePrivateRepositorySettingsProcedure,
+		svc.UpdatePrivateRepositorySettings,
+		connect.WithSchema(apiServiceMethods.ByName("UpdatePrivateRepositorySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceDeleteMyAccountHandler := connect.NewUnaryHandler(
+		ApiServiceDeleteMyAccountProcedure,
+		svc.DeleteMyAccount,
+		connect.WithSchema(apiServiceMethods.ByName("DeleteMyAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiServiceExportMyDataHandler := connect.NewUnaryHandler(
+		ApiServiceExportMyDataProcedure,
+		svc.ExportMyData,
+		connect.WithSchema(apiServiceMethods.ByName("ExportMyData")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.ApiService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiServiceSyncProcedure:
@@ -222,6 +926,68 @@ func NewApiServiceHandler(svc ApiServiceHandler, opts ...connect.HandlerOption)
 			apiServiceLogoutHandler.ServeHTTP(w, r)
 		case ApiServiceToggleUserOnboardedProcedure:
 			apiServiceToggleUserOnboardedHandler.ServeHTTP(w, r)
+		case ApiServiceMarkReleaseReadProcedure:
+			apiServiceMarkReleaseReadHandler.ServeHTTP(w, r)
+		case ApiServiceMarkRepositoryReadProcedure:
+			apiServiceMarkRepositoryReadHandler.ServeHTTP(w, r)
+		case ApiServiceMarkAllReadProcedure:
+			apiServiceMarkAllReadHandler.ServeHTTP(w, r)
+		case ApiServiceGetBookmarksProcedure:
+			apiServiceGetBookmarksHandler.ServeHTTP(w, r)
+		case ApiServiceAddBookmarkProcedure:
+			apiServiceAddBookmarkHandler.ServeHTTP(w, r)
+		case ApiServiceRemoveBookmarkProcedure:
+			apiServiceRemoveBookmarkHandler.ServeHTTP(w, r)
+		case ApiServiceMuteRepositoryProcedure:
+			apiServiceMuteRepositoryHandler.ServeHTTP(w, r)
+		case ApiServiceSnoozeRepositoryProcedure:
+			apiServiceSnoozeRepositoryHandler.ServeHTTP(w, r)
+		case ApiServiceGetMutedRepositoriesProcedure:
+			apiServiceGetMutedRepositoriesHandler.ServeHTTP(w, r)
+		case ApiServiceGetGroupsProcedure:
+			apiServiceGetGroupsHandler.ServeHTTP(w, r)
+		case ApiServiceCreateGroupProcedure:
+			apiServiceCreateGroupHandler.ServeHTTP(w, r)
+		case ApiServiceRenameGroupProcedure:
+			apiServiceRenameGroupHandler.ServeHTTP(w, r)
+		case ApiServiceDeleteGroupProcedure:
+			apiServiceDeleteGroupHandler.ServeHTTP(w, r)
+		case ApiServiceAddRepositoryToGroupProcedure:
+			apiServiceAddRepositoryToGroupHandler.ServeHTTP(w, r)
+		case ApiServiceRemoveRepositoryFromGroupProcedure:
+			apiServiceRemoveRepositoryFromGroupHandler.ServeHTTP(w, r)
+		case ApiServiceGetListsProcedure:
+			apiServiceGetListsHandler.ServeHTTP(w, r)
+		case ApiServiceSearchReleasesProcedure:
+			apiServiceSearchReleasesHandler.ServeHTTP(w, r)
+		case ApiServiceGetReleaseProcedure:
+			apiServiceGetReleaseHandler.ServeHTTP(w, r)
+		case ApiServiceGetReleaseDiffProcedure:
+			apiServiceGetReleaseDiffHandler.ServeHTTP(w, r)
+		case ApiServiceGetReleaseHistorySettingsProcedure:
+			apiServiceGetReleaseHistorySettingsHandler.ServeHTTP(w, r)
+		case ApiServiceUpdateReleaseHistorySettingsProcedure:
+			apiServiceUpdateReleaseHistorySettingsHandler.ServeHTTP(w, r)
+		case ApiServiceGetSessionsProcedure:
+			apiServiceGetSessionsHandler.ServeHTTP(w, r)
+		case ApiServiceRevokeSessionProcedure:
+			apiServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case ApiServiceRevokeAllSessionsProcedure:
+			apiServiceRevokeAllSessionsHandler.ServeHTTP(w, r)
+		case ApiServiceCreateApiTokenProcedure:
+			apiServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case ApiServiceGetApiTokensProcedure:
+			apiServiceGetApiTokensHandler.ServeHTTP(w, r)
+		case ApiServiceRevokeApiTokenProcedure:
+			apiServiceRevokeApiTokenHandler.ServeHTTP(w, r)
+		case ApiServiceGetPrivateRepositorySettingsProcedure:
+			apiServiceGetPrivateRepositorySettingsHandler.ServeHTTP(w, r)
+		case ApiServiceUpdatePrivateRepositorySettingsProcedure:
+			apiServiceUpdatePrivateRepositorySettingsHandler.ServeHTTP(w, r)
+		case ApiServiceDeleteMyAccountProcedure:
+			apiServiceDeleteMyAccountHandler.ServeHTTP(w, r)
+		case ApiServiceExportMyDataProcedure:
+			apiServiceExportMyDataHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -255,9 +1021,134 @@ func (UnimplementedApiServiceHandler) ToggleUserOnboarded(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.ToggleUserOnboarded is not implemented"))
 }
 
+func (UnimplementedApiServiceHandler) MarkReleaseRead(context.Context, *connect.Request[v1.MarkReleaseReadRequest]) (*connect.Response[v1.MarkReleaseReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.MarkReleaseRead is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) MarkRepositoryRead(context.Context, *connect.Request[v1.MarkRepositoryReadRequest]) (*connect.Response[v1.MarkRepositoryReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.MarkRepositoryRead is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) MarkAllRead(context.Context, *connect.Request[v1.MarkAllReadRequest]) (*connect.Response[v1.MarkAllReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.MarkAllRead is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetBookmarks(context.Context, *connect.Request[v1.GetBookmarksRequest]) (*connect.Response[v1.GetBookmarksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetBookmarks is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) AddBookmark(context.Context, *connect.Request[v1.AddBookmarkRequest]) (*connect.Response[v1.AddBookmarkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.AddBookmark is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RemoveBookmark(context.Context, *connect.Request[v1.RemoveBookmarkRequest]) (*connect.Response[v1.RemoveBookmarkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RemoveBookmark is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) MuteRepository(context.Context, *connect.Request[v1.MuteRepositoryRequest]) (*connect.Response[v1.MuteRepositoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.MuteRepository is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) SnoozeRepository(context.Context, *connect.Request[v1.SnoozeRepositoryRequest]) (*connect.Response[v1.SnoozeRepositoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.SnoozeRepository is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetMutedRepositories(context.Context, *connect.Request[v1.GetMutedRepositoriesRequest]) (*connect.Response[v1.GetMutedRepositoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetMutedRepositories is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetGroups(context.Context, *connect.Request[v1.GetGroupsRequest]) (*connect.Response[v1.GetGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetGroups is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) CreateGroup(context.Context, *connect.Request[v1.CreateGroupRequest]) (*connect.Response[v1.CreateGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.CreateGroup is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RenameGroup(context.Context, *connect.Request[v1.RenameGroupRequest]) (*connect.Response[v1.RenameGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RenameGroup is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) DeleteGroup(context.Context, *connect.Request[v1.DeleteGroupRequest]) (*connect.Response[v1.DeleteGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.DeleteGroup is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) AddRepositoryToGroup(context.Context, *connect.Request[v1.AddRepositoryToGroupRequest]) (*connect.Response[v1.AddRepositoryToGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.AddRepositoryToGroup is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RemoveRepositoryFromGroup(context.Context, *connect.Request[v1.RemoveRepositoryFromGroupRequest]) (*connect.Response[v1.RemoveRepositoryFromGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RemoveRepositoryFromGroup is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetLists(context.Context, *connect.Request[v1.GetListsRequest]) (*connect.Response[v1.GetListsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetLists is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) SearchReleases(context.Context, *connect.Request[v1.SearchReleasesRequest]) (*connect.Response[v1.SearchReleasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.SearchReleases is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetRelease(context.Context, *connect.Request[v1.GetReleaseRequest]) (*connect.Response[v1.GetReleaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetRelease is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetReleaseDiff(context.Context, *connect.Request[v1.GetReleaseDiffRequest]) (*connect.Response[v1.GetReleaseDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetReleaseDiff is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetReleaseHistorySettings(context.Context, *connect.Request[v1.GetReleaseHistorySettingsRequest]) (*connect.Response[v1.GetReleaseHistorySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetReleaseHistorySettings is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) UpdateReleaseHistorySettings(context.Context, *connect.Request[v1.UpdateReleaseHistorySettingsRequest]) (*connect.Response[v1.UpdateReleaseHistorySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.UpdateReleaseHistorySettings is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetSessions is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RevokeAllSessions(context.Context, *connect.Request[v1.RevokeAllSessionsRequest]) (*connect.Response[v1.RevokeAllSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RevokeAllSessions is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetApiTokens(context.Context, *connect.Request[v1.GetApiTokensRequest]) (*connect.Response[v1.GetApiTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetApiTokens is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.RevokeApiToken is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) GetPrivateRepositorySettings(context.Context, *connect.Request[v1.GetPrivateRepositorySettingsRequest]) (*connect.Response[v1.GetPrivateRepositorySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.GetPrivateRepositorySettings is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) UpdatePrivateRepositorySettings(context.Context, *connect.Request[v1.UpdatePrivateRepositorySettingsRequest]) (*connect.Response[v1.UpdatePrivateRepositorySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.UpdatePrivateRepositorySettings is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.DeleteMyAccount is not implemented"))
+}
+
+func (UnimplementedApiServiceHandler) ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ApiService.ExportMyData is not implemented"))
+}
+
 // AuthServiceClient is a client for the api.v1.AuthService service.
 type AuthServiceClient interface {
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
+	GetLoginProviders(context.Context, *connect.Request[v1.GetLoginProvidersRequest]) (*connect.Response[v1.GetLoginProvidersResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the api.v1.AuthService service. By default, it uses
@@ -277,12 +1168,19 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
 			connect.WithClientOptions(opts...),
 		),
+		getLoginProviders: connect.NewClient[v1.GetLoginProvidersRequest, v1.GetLoginProvidersResponse](
+			httpClient,
+			baseURL+AuthServiceGetLoginProvidersProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetLoginProviders")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	refreshToken *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	refreshToken      *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	getLoginProviders *connect.Client[v1.GetLoginProvidersRequest, v1.GetLoginProvidersResponse]
 }
 
 // RefreshToken calls api.v1.AuthService.RefreshToken.
@@ -290,9 +1188,15 @@ func (c *authServiceClient) RefreshToken(ctx context.Context, req *connect.Reque
 	return c.refreshToken.CallUnary(ctx, req)
 }
 
+// GetLoginProviders calls api.v1.AuthService.GetLoginProviders.
+func (c *authServiceClient) GetLoginProviders(ctx context.Context, req *connect.Request[v1.GetLoginProvidersRequest]) (*connect.Response[v1.GetLoginProvidersResponse], error) {
+	return c.getLoginProviders.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the api.v1.AuthService service.
 type AuthServiceHandler interface {
 	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
+	GetLoginProviders(context.Context, *connect.Request[v1.GetLoginProvidersRequest]) (*connect.Response[v1.GetLoginProvidersResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -308,10 +1212,18 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceGetLoginProvidersHandler := connect.NewUnaryHandler(
+		AuthServiceGetLoginProvidersProcedure,
+		svc.GetLoginProviders,
+		connect.WithSchema(authServiceMethods.ByName("GetLoginProviders")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceRefreshTokenProcedure:
 			authServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case AuthServiceGetLoginProvidersProcedure:
+			authServiceGetLoginProvidersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -324,3 +1236,7 @@ type UnimplementedAuthServiceHandler struct{}
 func (UnimplementedAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.RefreshToken is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) GetLoginProviders(context.Context, *connect.Request[v1.GetLoginProvidersRequest]) (*connect.Response[v1.GetLoginProvidersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AuthService.GetLoginProviders is not implemented"))
+}
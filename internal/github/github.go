@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"golang.org/x/oauth2"
 )
@@ -39,15 +40,71 @@ func NewGitHubService(ctx context.Context, oauthConfig *oauth2.Config, token *oa
 	}, refreshedToken, nil
 }
 
+// PrivateRepositoryScope is the OAuth scope GitHub requires for reading private repositories
+const PrivateRepositoryScope = "repo"
+
+// GrantedScopes returns the scopes GitHub granted with a freshly exchanged token, they are lost once the token is stored
+func GrantedScopes(token *oauth2.Token) []string {
+	scope, _ := token.Extra("scope").(string)
+	if scope == "" {
+		return []string{}
+	}
+
+	// GitHub separates scopes with commas, unlike the spaces of the OAuth specification
+	return strings.FieldsFunc(scope, func(r rune) bool { return r == ',' || r == ' ' })
+}
+
+// RevokeGrant revokes the authorization a user gave the OAuth app, which invalidates all of the app's tokens for that user
+func RevokeGrant(ctx context.Context, oauthConfig *oauth2.Config, token *oauth2.Token) error {
+	// GitHub identifies the grant by a valid access token, an expired one is refreshed first
+	newToken, err := oauthConfig.TokenSource(ctx, token).Token()
+	var retrieveError *oauth2.RetrieveError
+	if errors.As(err, &retrieveError) && retrieveError.Response != nil && retrieveError.Response.StatusCode < http.StatusInternalServerError {
+		// The refresh token was rejected, so the grant is gone already
+		return nil
+	} else if err != nil {
+		return errors.Join(err, errors.New("failed to refresh token"))
+	}
+
+	body, err := json.Marshal(map[string]string{"access_token": newToken.AccessToken})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("https://api.github.com/applications/%s/grant", oauthConfig.ClientID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(oauthConfig.ClientID, oauthConfig.ClientSecret)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to revoke grant"))
+	}
+	defer resp.Body.Close()
+
+	// Not found means the token isn't valid anymore, e.g. because the user revoked access on GitHub
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected response from GitHub, while revoking grant: %s", resp.Status)
+	}
+
+	return nil
+}
+
 var pageSize = 25
 
+// repositoriesPageSize is larger than pageSize, as starred and watched repositories are listed without their releases
+var repositoriesPageSize = 100
+
+// GetStarredRepos returns the viewer's starred repositories, without their releases
 func (s *GitHubService) GetStarredRepos(ctx context.Context) iter.Seq2[*Repository, error] {
 	return func(yield func(*Repository, error) bool) {
 		hasNextPage := true
 		after := ""
 		for hasNextPage {
 			requestBody := make(map[string]string)
-			requestBody["query"] = StarredReposQuery(pageSize, after)
+			requestBody["query"] = StarredReposQuery(repositoriesPageSize, after)
 			requestJson, err := json.Marshal(requestBody)
 			if err != nil {
 				yield(nil, err)
@@ -105,13 +162,14 @@ func (s *GitHubService) GetStarredRepos(ctx context.Context) iter.Seq2[*Reposito
 	}
 }
 
+// GetWatchingRepos returns the viewer's watched repositories, without their releases
 func (s *GitHubService) GetWatchingRepos(ctx context.Context) iter.Seq2[*Repository, error] {
 	return func(yield func(*Repository, error) bool) {
 		hasNextPage := true
 		after := ""
 		for hasNextPage {
 			requestBody := make(map[string]string)
-			requestBody["query"] = WatchingReposQuery(pageSize, after)
+			requestBody["query"] = WatchingReposQuery(repositoriesPageSize, after)
 			requestJson, err := json.Marshal(requestBody)
 			if err != nil {
 				yield(nil, err)
@@ -169,6 +227,289 @@ func (s *GitHubService) GetWatchingRepos(ctx context.Context) iter.Seq2[*Reposit
 	}
 }
 
+// RepositoriesBatchSize is how many repositories GetRepositories looks up at most in one request
+const RepositoriesBatchSize = 50
+
+// GetRepositories looks up repositories by their node IDs in a single request, each with its releasesDepth most recent releases.
+// The result is in the order of ids, repositories that were deleted, can't be accessed with this token or failed to load are nil
+// and their IDs are returned as failed, so a single broken repository doesn't hold back the rest of the batch.
+func (s *GitHubService) GetRepositories(ctx context.Context, ids []string, releasesDepth int) ([]*Repository, []string, error) {
+	if len(ids) > RepositoriesBatchSize {
+		return nil, nil, fmt.Errorf("too many repositories, at most %d can be fetched at once", RepositoriesBatchSize)
+	}
+
+	var repositoriesResponse RepositoriesResponse
+	err := s.graphQLRequest(ctx, RepositoriesQuery(ids, releasesDepth), &repositoriesResponse)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("failed to fetch repositories"))
+	}
+
+	if repositoriesResponse.Message != "" {
+		return nil, nil, fmt.Errorf("failed to fetch repositories(api error): %s", repositoriesResponse.Message)
+	}
+
+	if len(repositoriesResponse.Data.Nodes) != len(ids) {
+		// Without nodes there is nothing to attribute the errors to, so they are reported for the whole request
+		if len(repositoriesResponse.Errors) > 0 {
+			return nil, nil, errors.Join(errors.New("failed to fetch repositories (graphql error)"), errors.New(repositoriesResponse.Errors[0].Message))
+		}
+
+		return nil, nil, fmt.Errorf("unexpected response from GitHub, asked for %d repositories but got %d", len(ids), len(repositoriesResponse.Data.Nodes))
+	}
+
+	repositories := repositoriesResponse.Data.Nodes
+
+	// Errors of a single repository, whether it is NOT_FOUND or anything else, only null out that node
+	for _, err := range repositoriesResponse.Errors {
+		index, ok := nodeIndex(err.Path)
+		if !ok || index >= len(repositories) {
+			return nil, nil, errors.Join(errors.New("failed to fetch repositories (graphql error)"), errors.New(err.Message))
+		}
+
+		repositories[index] = nil
+	}
+
+	var failedIDs []string
+	for i, repository := range repositories {
+		if repository == nil {
+			failedIDs = append(failedIDs, ids[i])
+		}
+	}
+
+	return repositories, failedIDs, nil
+}
+
+// nodeIndex returns the index of the node a GraphQL error path like ["nodes", 3, "releases"] points at
+func nodeIndex(path []any) (int, bool) {
+	if len(path) < 2 || path[0] != "nodes" {
+		return 0, false
+	}
+
+	// JSON numbers are decoded as float64
+	index, ok := path[1].(float64)
+	if !ok || index < 0 {
+		return 0, false
+	}
+
+	return int(index), true
+}
+
+var releasesPageSize = 100
+
+// GetRepositoryReleases pages through a repository's releases, newest first, until limit releases were returned
+func (s *GitHubService) GetRepositoryReleases(ctx context.Context, nameWithOwner string, limit int) iter.Seq2[*Release, error] {
+	return func(yield func(*Release, error) bool) {
+		owner, name, found := strings.Cut(nameWithOwner, "/")
+		if !found {
+			yield(nil, fmt.Errorf("invalid repository name: %s", nameWithOwner))
+			return
+		}
+
+		count := 0
+		hasNextPage := true
+		after := ""
+		for hasNextPage && count < limit {
+			var releasesResponse RepositoryReleasesResponse
+			err := s.graphQLRequest(ctx, RepositoryReleasesQuery(owner, name, min(releasesPageSize, limit-count), after), &releasesResponse)
+			if err != nil {
+				yield(nil, errors.Join(err, errors.New("failed to fetch repository releases")))
+				return
+			}
+
+			if len(releasesResponse.Errors) > 0 {
+				yield(nil, errors.Join(errors.New("failed to fetch repository releases (graphql error)"), errors.New(releasesResponse.Errors[0].Message)))
+				return
+			}
+
+			if releasesResponse.Message != "" {
+				yield(nil, fmt.Errorf("failed to fetch repository releases(api error): %s", releasesResponse.Message))
+				return
+			}
+
+			hasNextPage = releasesResponse.Data.Repository.Releases.PageInfo.HasNextPage
+			after = releasesResponse.Data.Repository.Releases.PageInfo.EndCursor
+
+			for _, release := range releasesResponse.Data.Repository.Releases.Nodes {
+				count++
+				if !yield(&release, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+var starListItemsPageSize = 100
+
+// GetStarLists returns the viewer's star lists, each with all of its repository IDs
+func (s *GitHubService) GetStarLists(ctx context.Context) iter.Seq2[*StarList, error] {
+	return func(yield func(*StarList, error) bool) {
+		hasNextPage := true
+		after := ""
+		for hasNextPage {
+			var starListsResponse StarListsResponse
+			err := s.graphQLRequest(ctx, StarListsQuery(pageSize, after, starListItemsPageSize), &starListsResponse)
+			if err != nil {
+				yield(nil, errors.Join(err, errors.New("failed to fetch star lists")))
+				return
+			}
+
+			if len(starListsResponse.Errors) > 0 {
+				for _, err := range starListsResponse.Errors {
+					slog.Info(fmt.Sprintf("Error: %s", err.Message))
+				}
+				yield(nil, errors.Join(errors.New("failed to fetch star lists (graphql error)"), errors.New(starListsResponse.Errors[0].Message)))
+				return
+			}
+
+			if starListsResponse.Message != "" {
+				yield(nil, fmt.Errorf("failed to fetch star lists(api error): %s", starListsResponse.Message))
+				return
+			}
+
+			hasNextPage = starListsResponse.Data.Viewer.Lists.PageInfo.HasNextPage
+			after = starListsResponse.Data.Viewer.Lists.PageInfo.EndCursor
+
+			for _, list := range starListsResponse.Data.Viewer.Lists.Nodes {
+				// Lists with more items than fit in the first page are fetched separately
+				items := list.Items
+				for items.PageInfo.HasNextPage {
+					var starListItemsResponse StarListItemsResponse
+					err := s.graphQLRequest(ctx, StarListItemsQuery(list.ID, starListItemsPageSize, items.PageInfo.EndCursor), &starListItemsResponse)
+					if err != nil {
+						yield(nil, errors.Join(err, errors.New("failed to fetch star list items")))
+						return
+					}
+
+					if len(starListItemsResponse.Errors) > 0 {
+						yield(nil, errors.Join(errors.New("failed to fetch star list items (graphql error)"), errors.New(starListItemsResponse.Errors[0].Message)))
+						return
+					}
+
+					if starListItemsResponse.Message != "" {
+						yield(nil, fmt.Errorf("failed to fetch star list items(api error): %s", starListItemsResponse.Message))
+						return
+					}
+
+					items = starListItemsResponse.Data.Node.Items
+					list.Items.Nodes = append(list.Items.Nodes, items.Nodes...)
+				}
+
+				if !yield(&list, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// graphQLRequest sends a query to the GitHub GraphQL API and decodes the response into response
+func (s *GitHubService) graphQLRequest(ctx context.Context, query string, response any) error {
+	requestJson, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.github.com/graphql", bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("User-Agent", "releases.one")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Join(err, errors.New("failed to make request to GitHub"))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from GitHub, status: %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// advisoriesPageLimit bounds how many pages of advisories are fetched for a single repository
+const advisoriesPageLimit = 10
+
+// GetRepositoryAdvisories returns the published security advisories of a repository, following the pages of the response.
+// These are only the advisories the repository published itself. Reviewed advisories of the GitHub Advisory Database can only
+// be looked up by package, on GraphQL as well as REST, and a repository doesn't tell which packages it publishes.
+func (s *GitHubService) GetRepositoryAdvisories(ctx context.Context, nameWithOwner string) ([]SecurityAdvisory, error) {
+	var advisories []SecurityAdvisory
+	url := fmt.Sprintf("https://api.github.com/repos/%s/security-advisories?state=published&per_page=100", nameWithOwner)
+
+	for page := 0; url != ""; page++ {
+		if page == advisoriesPageLimit {
+			slog.Warn(fmt.Sprintf("Repository %s has more than %d pages of security advisories, skipping the rest", nameWithOwner, advisoriesPageLimit))
+			break
+		}
+
+		pageAdvisories, nextURL, err := s.getRepositoryAdvisoriesPage(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		advisories = append(advisories, pageAdvisories...)
+		url = nextURL
+	}
+
+	return advisories, nil
+}
+
+// getRepositoryAdvisoriesPage fetches a single page of advisories and returns the URL of the next page, if there is one
+func (s *GitHubService) getRepositoryAdvisoriesPage(ctx context.Context, url string) ([]SecurityAdvisory, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.Header.Set("User-Agent", "releases.one")
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", errors.Join(err, errors.New("failed to fetch security advisories"))
+	}
+	defer resp.Body.Close()
+
+	// Repositories without advisory access simply have none
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, "", nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("unexpected response from GitHub, while fetching security advisories: %s", resp.Status)
+	}
+
+	var advisories []SecurityAdvisory
+	if err := json.NewDecoder(resp.Body).Decode(&advisories); err != nil {
+		return nil, "", errors.Join(err, errors.New("failed to decode response"))
+	}
+
+	return advisories, nextPageURL(resp.Header.Get("Link")), nil
+}
+
+// nextPageURL returns the next page of a paginated REST response from its Link header, e.g.
+// <https://api.github.com/...&page=2>; rel="next", <https://api.github.com/...&page=5>; rel="last"
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		url, params, found := strings.Cut(strings.TrimSpace(part), ";")
+		if !found || !strings.HasPrefix(url, "<") || !strings.HasSuffix(url, ">") {
+			continue
+		}
+
+		for _, param := range strings.Split(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.TrimSuffix(strings.TrimPrefix(url, "<"), ">")
+			}
+		}
+	}
+
+	return ""
+}
+
 func (s *GitHubService) GetUserData(ctx context.Context) (*UserData, error) {
 	url := "https://api.github.com/user"
 	resp, err := s.client.Get(url)
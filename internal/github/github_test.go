@@ -0,0 +1,27 @@
+package github
+
+import "testing"
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		link string
+		want string
+	}{
+		{
+			`<https://api.github.com/repos/a/b/security-advisories?per_page=100&page=2>; rel="next", <https://api.github.com/repos/a/b/security-advisories?per_page=100&page=3>; rel="last"`,
+			"https://api.github.com/repos/a/b/security-advisories?per_page=100&page=2",
+		},
+		{
+			`<https://api.github.com/repos/a/b/security-advisories?per_page=100&page=1>; rel="prev", <https://api.github.com/repos/a/b/security-advisories?per_page=100&page=1>; rel="first"`,
+			"",
+		},
+		{"", ""},
+		{"not a link header", ""},
+	}
+
+	for _, test := range tests {
+		if got := nextPageURL(test.link); got != test.want {
+			t.Errorf("nextPageURL(%q) = %q, want %q", test.link, got, test.want)
+		}
+	}
+}
@@ -2,9 +2,40 @@ package github
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
+var releaseFragment = `
+fragment Release on Release {
+  id
+  name
+  tagName
+  isDraft
+  isPrerelease
+  createdAt
+  publishedAt
+  url
+  description
+  shortDescriptionHTML
+  author {
+    name
+    login
+  }
+  releaseAssets(first: 50) {
+    nodes {
+      id
+      name
+      contentType
+      size
+      downloadCount
+      downloadUrl
+    }
+  }
+}
+`
+
 var repositoryFragment = `
 fragment Repository on Repository {
   id
@@ -12,25 +43,20 @@ fragment Repository on Repository {
   url
   openGraphImageUrl
   isPrivate
-  releases(first: 3, orderBy: { field: CREATED_AT, direction: DESC }) {
+}
+`
+
+// repositoryWithReleasesFragmentTemplate takes the number of releases to fetch per repository
+var repositoryWithReleasesFragmentTemplate = `
+fragment RepositoryWithReleases on Repository {
+  ...Repository
+  releases(first: %d, orderBy: { field: CREATED_AT, direction: DESC }) {
     nodes {
-      id
-      name
-      tagName
-      isDraft
-      isPrerelease
-      publishedAt
-      url
-      description
-      shortDescriptionHTML
-      author {
-        name
-        login
-      }
+      ...Release
     }
   }
 }
-`
+` + repositoryFragment + releaseFragment
 
 var WatchingReposQueryTemplate = `
 %s
@@ -78,6 +104,105 @@ query StarredRepositories {
 }
 `
 
+var RepositoriesQueryTemplate = `
+%s
+query Repositories {
+  rateLimit {
+    limit
+    cost
+    remaining
+    resetAt
+  }
+  nodes(ids: [%s]) {
+    ...RepositoryWithReleases
+  }
+}
+`
+
+var StarListsQueryTemplate = `
+query StarLists {
+  rateLimit {
+    limit
+    cost
+    remaining
+    resetAt
+  }
+  viewer {
+    lists(first: %d, after: "%s") {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        id
+        name
+        slug
+        items(first: %d) {
+          pageInfo {
+            hasNextPage
+            endCursor
+          }
+          nodes {
+            ... on Repository {
+              id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+var StarListItemsQueryTemplate = `
+query StarListItems {
+  rateLimit {
+    limit
+    cost
+    remaining
+    resetAt
+  }
+  node(id: "%s") {
+    ... on UserList {
+      items(first: %d, after: "%s") {
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+        nodes {
+          ... on Repository {
+            id
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+var RepositoryReleasesQueryTemplate = `
+%s
+query RepositoryReleases {
+  rateLimit {
+    limit
+    cost
+    remaining
+    resetAt
+  }
+  repository(owner: "%s", name: "%s") {
+    releases(first: %d, after: "%s", orderBy: { field: CREATED_AT, direction: DESC }) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        ...Release
+      }
+    }
+  }
+}
+`
+
 func StarredReposQuery(first int, after string) string {
 	return fmt.Sprintf(StarredReposQueryTemplate, repositoryFragment, first, after)
 }
@@ -86,6 +211,28 @@ func WatchingReposQuery(first int, after string) string {
 	return fmt.Sprintf(WatchingReposQueryTemplate, repositoryFragment, first, after)
 }
 
+// RepositoriesQuery looks up repositories by their node IDs, each with its releasesDepth most recent releases
+func RepositoriesQuery(ids []string, releasesDepth int) string {
+	quotedIDs := make([]string, len(ids))
+	for i, id := range ids {
+		quotedIDs[i] = strconv.Quote(id)
+	}
+
+	return fmt.Sprintf(RepositoriesQueryTemplate, fmt.Sprintf(repositoryWithReleasesFragmentTemplate, releasesDepth), strings.Join(quotedIDs, ", "))
+}
+
+func RepositoryReleasesQuery(owner string, name string, first int, after string) string {
+	return fmt.Sprintf(RepositoryReleasesQueryTemplate, releaseFragment, owner, name, first, after)
+}
+
+func StarListsQuery(first int, after string, firstItems int) string {
+	return fmt.Sprintf(StarListsQueryTemplate, first, after, firstItems)
+}
+
+func StarListItemsQuery(listID string, first int, after string) string {
+	return fmt.Sprintf(StarListItemsQueryTemplate, listID, first, after)
+}
+
 type StarredReposResponse struct {
 	Message string `json:"message"`
 	Errors  []struct {
@@ -134,31 +281,170 @@ type WatchingReposResponse struct {
 	} `json:"data"`
 }
 
+type RepositoriesResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+		// Path points at the field that failed, e.g. ["nodes", 3] for the fourth repository
+		Path []any `json:"path"`
+	} `json:"errors"`
+	Data struct {
+		// Nodes that don't exist anymore or can't be accessed are null
+		Nodes     []*Repository `json:"nodes"`
+		RateLimit struct {
+			ResetAt   time.Time `json:"resetAt"`
+			Limit     int       `json:"limit"`
+			Cost      int       `json:"cost"`
+			Remaining int       `json:"remaining"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+}
+
+type StarListsResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Data struct {
+		Viewer struct {
+			Lists struct {
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+				Nodes []StarList `json:"nodes"`
+			} `json:"lists"`
+		} `json:"viewer"`
+		RateLimit struct {
+			ResetAt   time.Time `json:"resetAt"`
+			Limit     int       `json:"limit"`
+			Cost      int       `json:"cost"`
+			Remaining int       `json:"remaining"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+}
+
+type StarListItemsResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Data struct {
+		Node struct {
+			Items StarListItems `json:"items"`
+		} `json:"node"`
+		RateLimit struct {
+			ResetAt   time.Time `json:"resetAt"`
+			Limit     int       `json:"limit"`
+			Cost      int       `json:"cost"`
+			Remaining int       `json:"remaining"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+}
+
+// StarList is one of the viewer's GitHub Lists, used to organize starred repositories
+type StarList struct {
+	ID    string        `json:"id"`
+	Name  string        `json:"name"`
+	Slug  string        `json:"slug"`
+	Items StarListItems `json:"items"`
+}
+
+type StarListItems struct {
+	PageInfo struct {
+		EndCursor   string `json:"endCursor"`
+		HasNextPage bool   `json:"hasNextPage"`
+	} `json:"pageInfo"`
+	Nodes []struct {
+		ID string `json:"id"`
+	} `json:"nodes"`
+}
+
+type RepositoryReleasesResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Data struct {
+		Repository struct {
+			Releases struct {
+				PageInfo struct {
+					EndCursor   string `json:"endCursor"`
+					HasNextPage bool   `json:"hasNextPage"`
+				} `json:"pageInfo"`
+				Nodes []Release `json:"nodes"`
+			} `json:"releases"`
+		} `json:"repository"`
+		RateLimit struct {
+			ResetAt   time.Time `json:"resetAt"`
+			Limit     int       `json:"limit"`
+			Cost      int       `json:"cost"`
+			Remaining int       `json:"remaining"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+}
+
 type Repository struct {
 	ID                string `json:"id"`
 	NameWithOwner     string `json:"nameWithOwner"`
 	URL               string `json:"url"`
 	OpenGraphImageURL string `json:"openGraphImageUrl"`
 	Releases          struct {
-		Nodes []struct {
-			ID          string    `json:"id"`
-			PublishedAt time.Time `json:"publishedAt"`
-			Author      struct {
-				Name  string `json:"name"`
-				Login string `json:"login"`
-			} `json:"author"`
-			Name                 string `json:"name"`
-			URL                  string `json:"url"`
-			TagName              string `json:"tagName"`
-			Description          string `json:"description"`
-			ShortDescriptionHTML string `json:"shortDescriptionHTML"`
-			IsDraft              bool   `json:"isDraft"`
-			IsPrerelease         bool   `json:"isPrerelease"`
-		} `json:"nodes"`
+		Nodes []Release `json:"nodes"`
 	} `json:"releases" hash:"ignore"`
 	IsPrivate bool `json:"isPrivate"`
 }
 
+type Release struct {
+	ID string `json:"id"`
+	// Releases are listed by when they were created, which is earlier than when they were published for drafts
+	CreatedAt   time.Time `json:"createdAt"`
+	PublishedAt time.Time `json:"publishedAt"`
+	Author      struct {
+		Name  string `json:"name"`
+		Login string `json:"login"`
+	} `json:"author"`
+	Name                 string `json:"name"`
+	URL                  string `json:"url"`
+	TagName              string `json:"tagName"`
+	Description          string `json:"description"`
+	ShortDescriptionHTML string `json:"shortDescriptionHTML"`
+	IsDraft              bool   `json:"isDraft"`
+	IsPrerelease         bool   `json:"isPrerelease"`
+	ReleaseAssets        struct {
+		Nodes []ReleaseAsset `json:"nodes"`
+	} `json:"releaseAssets"`
+}
+
+type ReleaseAsset struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	// Download counts change constantly and must not make the release look edited
+	DownloadCount int    `json:"downloadCount" hash:"ignore"`
+	DownloadURL   string `json:"downloadUrl"`
+}
+
+// SecurityAdvisory is a published repository security advisory, as returned by the REST API
+type SecurityAdvisory struct {
+	GhsaID          string    `json:"ghsa_id"`
+	CveID           string    `json:"cve_id"`
+	HTMLURL         string    `json:"html_url"`
+	Summary         string    `json:"summary"`
+	Severity        string    `json:"severity"`
+	PublishedAt     time.Time `json:"published_at"`
+	Vulnerabilities []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		VulnerableVersionRange string `json:"vulnerable_version_range"`
+		PatchedVersions        string `json:"patched_versions"`
+	} `json:"vulnerabilities"`
+}
+
 type UserData struct {
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
@@ -0,0 +1,322 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// keysRefreshInterval limits how often the provider's keys are fetched again for an unknown key ID,
+// so tokens with made up key IDs can't be used to hammer the provider
+const keysRefreshInterval = time.Minute
+
+// supportedAlgorithms are the ID token signing algorithms that are accepted
+var supportedAlgorithms = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
+// Claims are the claims of an ID token that are used to identify a user
+type Claims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	AuthorizedParty   string `json:"azp,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect provider, configured through discovery
+type Provider struct {
+	Issuer       string
+	oauth2Config *oauth2.Config
+	jwksURI      string
+	httpClient   *http.Client
+
+	keysMutex       sync.Mutex
+	keys            map[string]any
+	keysRefreshedAt time.Time
+}
+
+// Discover configures a provider from the discovery document of issuer
+func Discover(ctx context.Context, issuer string, clientID string, clientSecret string, redirectURL string) (*Provider, error) {
+	httpClient := http.DefaultClient
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	var document discoveryDocument
+	err := getJSON(ctx, httpClient, issuer+"/.well-known/openid-configuration", &document)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to fetch discovery document"))
+	}
+
+	// The issuer has to match exactly, otherwise ID tokens of another provider could be accepted
+	if strings.TrimSuffix(document.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery document is for issuer %s instead of %s", document.Issuer, issuer)
+	}
+
+	if document.AuthorizationEndpoint == "" || document.TokenEndpoint == "" || document.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing an endpoint")
+	}
+
+	return &Provider{
+		Issuer: document.Issuer,
+		oauth2Config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"openid", "profile", "email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  document.AuthorizationEndpoint,
+				TokenURL: document.TokenEndpoint,
+			},
+		},
+		jwksURI:    document.JWKSURI,
+		httpClient: httpClient,
+		keys:       map[string]any{},
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the provider's login, using PKCE with the S256 challenge of codeVerifier
+func (p *Provider) AuthCodeURL(state string, nonce string, codeVerifier string) string {
+	return p.oauth2Config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier), oauth2.SetAuthURLParam("nonce", nonce))
+}
+
+// Exchange redeems an authorization code and returns the claims of the verified ID token
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*Claims, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+
+	token, err := p.oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to exchange code"))
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response has no id token")
+	}
+
+	return p.Verify(ctx, rawIDToken, nonce)
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) Verify(ctx context.Context, rawIDToken string, nonce string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(rawIDToken, &Claims{}, func(token *jwt.Token) (any, error) {
+		return p.key(ctx, token)
+	},
+		jwt.WithValidMethods(supportedAlgorithms),
+		jwt.WithIssuer(p.Issuer),
+		jwt.WithAudience(p.oauth2Config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid id token"))
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok {
+		return nil, errors.New("could not parse claims unknown claims type")
+	}
+
+	// With more than one audience the token has to be meant for us in particular
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.oauth2Config.ClientID {
+		return nil, errors.New("id token was issued to another party")
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("nonce does not match")
+	}
+
+	return claims, nil
+}
+
+// key returns the public key a token was signed with, fetching the provider's keys again when it rotated them
+func (p *Provider) key(ctx context.Context, token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	p.keysMutex.Lock()
+	defer p.keysMutex.Unlock()
+
+	key, ok := p.lookupKey(kid)
+	if !ok && time.Since(p.keysRefreshedAt) > keysRefreshInterval {
+		keys, err := fetchKeys(ctx, p.httpClient, p.jwksURI)
+		if err != nil {
+			return nil, err
+		}
+
+		p.keys = keys
+		p.keysRefreshedAt = time.Now()
+		key, ok = p.lookupKey(kid)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	// The algorithm has to fit the key, a token can't pick another one
+	switch key.(type) {
+	case *rsa.PublicKey:
+		ok = token.Method.Alg() == jwt.SigningMethodRS256.Alg()
+	case *ecdsa.PublicKey:
+		ok = token.Method.Alg() == jwt.SigningMethodES256.Alg()
+	case ed25519.PublicKey:
+		ok = token.Method.Alg() == jwt.SigningMethodEdDSA.Alg()
+	}
+
+	if !ok {
+		return nil, errors.New("unexpected signing method")
+	}
+
+	return key, nil
+}
+
+// lookupKey finds a key by ID, tokens without a key ID are only accepted while the provider has a single key
+func (p *Provider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	Curve   string `json:"crv"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// fetchKeys fetches the signing keys of a provider, keys of unsupported types are skipped
+func fetchKeys(ctx context.Context, httpClient *http.Client, jwksURI string) (map[string]any, error) {
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	err := getJSON(ctx, httpClient, jwksURI, &keySet)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to fetch keys"))
+	}
+
+	keys := map[string]any{}
+	for _, jsonKey := range keySet.Keys {
+		if jsonKey.Use != "" && jsonKey.Use != "sig" {
+			continue
+		}
+
+		key, err := parseKey(jsonKey)
+		if err != nil {
+			continue
+		}
+
+		keys[jsonKey.KeyID] = key
+	}
+
+	return keys, nil
+}
+
+func parseKey(jsonKey jsonWebKey) (any, error) {
+	switch {
+	case jsonKey.KeyType == "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jsonKey.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jsonKey.E)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("rsa exponent is too large")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case jsonKey.KeyType == "EC" && jsonKey.Curve == "P-256":
+		x, err := base64.RawURLEncoding.DecodeString(jsonKey.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(jsonKey.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, errors.New("ec key is not on the curve")
+		}
+
+		return publicKey, nil
+	case jsonKey.KeyType == "OKP" && jsonKey.Curve == "Ed25519":
+		x, err := base64.RawURLEncoding.DecodeString(jsonKey.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("ed25519 key has the wrong size")
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", jsonKey.KeyType)
+	}
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
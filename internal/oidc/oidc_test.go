@@ -0,0 +1,244 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// mockProvider is a minimal OpenID Connect provider, it hands out a code for the last authorization request
+type mockProvider struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	keyID    string
+	clientID string
+
+	codeChallenge string
+	nonce         string
+	// modifyClaims changes the claims of the next ID token, to test rejected tokens
+	modifyClaims func(claims *Claims)
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &mockProvider{key: key, keyID: "key-1", clientID: "client"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(discoveryDocument{
+			Issuer:                provider.server.URL,
+			AuthorizationEndpoint: provider.server.URL + "/authorize",
+			TokenEndpoint:         provider.server.URL + "/token",
+			JWKSURI:               provider.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []jsonWebKey{{
+			KeyType: "RSA",
+			KeyID:   provider.keyID,
+			Use:     "sig",
+			N:       base64.RawURLEncoding.EncodeToString(provider.key.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(provider.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+
+		verifierHash := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if r.Form.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != provider.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     provider.idToken(t),
+		})
+	})
+
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+
+	return provider
+}
+
+// authorize plays the provider's login page, which remembers the challenge and nonce of the request
+func (m *mockProvider) authorize(t *testing.T, authCodeURL string) {
+	parsed, err := url.Parse(authCodeURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Query().Get("code_challenge_method") != "S256" {
+		t.Fatal("expected a S256 code challenge")
+	}
+
+	m.codeChallenge = parsed.Query().Get("code_challenge")
+	m.nonce = parsed.Query().Get("nonce")
+}
+
+func (m *mockProvider) idToken(t *testing.T) string {
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.server.URL,
+			Subject:   "user-1",
+			Audience:  jwt.ClaimStrings{m.clientID},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Nonce:             m.nonce,
+		Email:             "user@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "user",
+	}
+
+	if m.modifyClaims != nil {
+		m.modifyClaims(claims)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.keyID
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func TestProvider_Login(t *testing.T) {
+	mock := newMockProvider(t)
+
+	provider, err := Discover(context.Background(), mock.server.URL, mock.clientID, "secret", "https://releases.one/api/oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codeVerifier := oauth2.GenerateVerifier()
+	mock.authorize(t, provider.AuthCodeURL("state", "nonce", codeVerifier))
+
+	claims, err := provider.Exchange(context.Background(), "code", codeVerifier, "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Subject != "user-1" || claims.Email != "user@example.com" || claims.PreferredUsername != "user" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	if _, err := provider.Exchange(context.Background(), "code", oauth2.GenerateVerifier(), "nonce"); err == nil {
+		t.Fatal("expected error for a wrong code verifier")
+	}
+}
+
+func TestProvider_RejectsInvalidIDTokens(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		nonce        string
+		modifyClaims func(claims *Claims)
+		signingKey   *rsa.PrivateKey
+	}{
+		"wrong nonce":     {nonce: "other"},
+		"wrong audience":  {modifyClaims: func(claims *Claims) { claims.Audience = jwt.ClaimStrings{"other"} }},
+		"wrong issuer":    {modifyClaims: func(claims *Claims) { claims.Issuer = "https://evil.example.com" }},
+		"expired":         {modifyClaims: func(claims *Claims) { claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }},
+		"no subject":      {modifyClaims: func(claims *Claims) { claims.Subject = "" }},
+		"other party":     {modifyClaims: func(claims *Claims) { claims.Audience = append(claims.Audience, "other") }},
+		"wrong signature": {signingKey: otherKey},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mock := newMockProvider(t)
+			mock.modifyClaims = test.modifyClaims
+
+			provider, err := Discover(context.Background(), mock.server.URL, mock.clientID, "secret", "https://releases.one/api/oidc")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			codeVerifier := oauth2.GenerateVerifier()
+			mock.authorize(t, provider.AuthCodeURL("state", "nonce", codeVerifier))
+
+			if test.signingKey != nil {
+				// The provider's keys are fetched first, the token is then signed by someone else
+				if _, err := provider.Exchange(context.Background(), "code", codeVerifier, "nonce"); err != nil {
+					t.Fatal(err)
+				}
+				mock.key = test.signingKey
+			}
+
+			nonce := "nonce"
+			if test.nonce != "" {
+				nonce = test.nonce
+			}
+
+			if _, err := provider.Exchange(context.Background(), "code", codeVerifier, nonce); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestProvider_KeyRotation(t *testing.T) {
+	mock := newMockProvider(t)
+
+	provider, err := Discover(context.Background(), mock.server.URL, mock.clientID, "secret", "https://releases.one/api/oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codeVerifier := oauth2.GenerateVerifier()
+	mock.authorize(t, provider.AuthCodeURL("state", "nonce", codeVerifier))
+
+	if _, err := provider.Exchange(context.Background(), "code", codeVerifier, "nonce"); err != nil {
+		t.Fatal(err)
+	}
+
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.key, mock.keyID = newKey, "key-2"
+
+	// Keys are only fetched again once the refresh interval passed
+	if _, err := provider.Exchange(context.Background(), "code", codeVerifier, "nonce"); err == nil {
+		t.Fatal("expected error while the keys are not refreshed")
+	}
+
+	provider.keysRefreshedAt = time.Time{}
+	if _, err := provider.Exchange(context.Background(), "code", codeVerifier, "nonce"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscover_RejectsOtherIssuer(t *testing.T) {
+	mock := newMockProvider(t)
+
+	if _, err := Discover(context.Background(), mock.server.URL+"/other", mock.clientID, "secret", ""); err == nil {
+		t.Fatal("expected error for a discovery document of another issuer")
+	}
+}
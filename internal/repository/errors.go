@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlDuplicateEntry is the MySQL error number for a write that violates a unique index
+const mysqlDuplicateEntry = 1062
+
+// IsDuplicateEntry reports whether err was caused by a write that violates a unique index, which tells a lost race
+// apart from other failures
+func IsDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestIsDuplicateEntry(t *testing.T) {
+	duplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'issuer-subject' for key 'issuer_subject'"}
+
+	if !IsDuplicateEntry(duplicate) || !IsDuplicateEntry(errors.Join(errors.New("failed to create user"), duplicate)) {
+		t.Fatal("expected a duplicate entry error")
+	}
+
+	if IsDuplicateEntry(&mysql.MySQLError{Number: 1452}) || IsDuplicateEntry(errors.New("Duplicate entry")) || IsDuplicateEntry(nil) {
+		t.Fatal("expected other errors to not be duplicate entry errors")
+	}
+}
@@ -6,55 +6,200 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
-type Release struct {
-	GithubID         string
-	ID               int32
-	RepositoryID     int32
-	Name             string
-	Url              string
-	TagName          string
-	Description      string
-	DescriptionShort string
-	Author           sql.NullString
-	IsPrerelease     bool
-	ReleasedAt       time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	Hash             uint64
+type ApiToken struct {
+	ID          int32
+	UserID      int32
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	CreatedAt   time.Time
+	LastUsedAt  sql.NullTime
+	ExpiresAt   sql.NullTime
 }
 
-type Repository struct {
+type Bookmark struct {
+	UserID    int32
+	ReleaseID int32
+	Note      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type FollowedRepository struct {
+	RepositoryID int32
+}
+
+type ReleaseAdvisory struct {
+	ReleaseID   int32
+	GhsaID      string
+	CveID       sql.NullString
+	Severity    string
+	Summary     string
+	Url         string
+	PublishedAt time.Time
+	CreatedAt   time.Time
+}
+
+type ReleaseAsset struct {
+	ID            int32
+	ReleaseID     int32
+	GithubID      string
+	Name          string
+	ContentType   string
+	Size          int64
+	DownloadCount int32
+	Url           string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ReleaseEdit struct {
 	ID           int32
-	GithubID     string
+	ReleaseID    int32
 	Name         string
-	Url          string
-	Private      bool
+	TagName      string
+	Description  string
+	IsPrerelease bool
+	EditedAt     time.Time
+	Diff         json.RawMessage
+}
+
+type ReleaseRead struct {
+	UserID    int32
+	ReleaseID int32
+	CreatedAt time.Time
+}
+
+type Release struct {
+	GithubID          string
+	ID                int32
+	RepositoryID      int32
+	Name              string
+	Url               string
+	TagName           string
+	Description       string
+	DescriptionShort  string
+	DescriptionText   string
+	Author            sql.NullString
+	IsPrerelease      bool
+	IsSecurity        bool
+	IsBreaking        bool
+	HasDeprecation    bool
+	HasMigrationGuide bool
+	VulnerabilityIds  string
+	SignalsDetected   bool
+	MajorVersion      sql.NullInt32
+	ReleasedAt        time.Time
+	GithubCreatedAt   sql.NullTime
+	FirstSeenAt       sql.NullTime
+	IsBackfill        bool
+	EditedAt          sql.NullTime
+	RetractedAt       sql.NullTime
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Hash              uint64
+}
+
+type Repository struct {
+	ID                 int32
+	GithubID           string
+	Name               string
+	Url                string
+	Private            bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	LastSyncedAt       sql.NullTime
+	AdvisoriesSyncedAt sql.NullTime
+	ImageUrl           string
+	ImageSize          int32
+	Hash               uint64
+	OrphanedAt         sql.NullTime
+	NextSyncAt         sql.NullTime
+}
+
+type RepositoryGroupMember struct {
+	GroupID      int32
+	RepositoryID int32
 	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastSyncedAt time.Time
-	ImageUrl     string
-	ImageSize    int32
-	Hash         uint64
+}
+
+type RepositoryGroup struct {
+	ID        int32
+	UserID    int32
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type RepositoryStar struct {
+	RepositoryID      int32
+	UserID            int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Type              int8
+	IsMuted           bool
+	SnoozedUntil      sql.NullTime
+	SnoozedUntilMajor sql.NullInt32
+}
+
+type Session struct {
+	ID                     string
+	UserID                 int32
+	RefreshTokenID         string
+	PreviousRefreshTokenID sql.NullString
+	RotatedAt              sql.NullTime
+	UserAgent              string
+	IpAddress              string
+	CreatedAt              time.Time
+	LastUsedAt             time.Time
+	ExpiresAt              time.Time
+	RevokedAt              sql.NullTime
+}
+
+type StarListRepository struct {
+	ListID       int32
 	RepositoryID int32
-	UserID       int32
-	CreatedAt    time.Time
 	UpdatedAt    time.Time
-	Type         int8
+}
+
+type StarList struct {
+	ID        int32
+	UserID    int32
+	GithubID  string
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type UserIdentity struct {
+	ID          int32
+	UserID      int32
+	Issuer      string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
 }
 
 type User struct {
-	ID           int32
-	Username     string
-	GithubID     uint64
-	GithubToken  GitHubToken
-	LastSyncedAt time.Time
-	PublicID     string
-	IsOnboarded  bool
-	IsPublic     bool
+	ID                int32
+	Username          string
+	GithubID          sql.NullInt64
+	GithubToken       GitHubToken
+	LastSyncedAt      time.Time
+	PublicID          string
+	IsOnboarded       bool
+	IsPublic          bool
+	ReadAllAt         sql.NullTime
+	PrivateFeedID     sql.NullString
+	ReleaseFetchDepth sql.NullInt32
+	ReleaseRetention  sql.NullInt32
+	GithubScopes      string
+	SyncPrivate       bool
 }
@@ -3,8 +3,11 @@ package repository
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 
+	"github.com/benjasper/releases.one/pkg/envelope"
 	"golang.org/x/oauth2"
 )
 
@@ -17,7 +20,24 @@ const (
 
 type GitHubToken oauth2.Token
 
-// Implementing `sql.Scanner` interface to read JSON from the database
+// tokenKeyring encrypts GitHub tokens at rest, it has to be set before any user is read or written
+var tokenKeyring *envelope.Keyring
+
+// SetTokenKeyring sets the keyring GitHub tokens are encrypted with
+func SetTokenKeyring(keyring *envelope.Keyring) {
+	tokenKeyring = keyring
+}
+
+// PrimaryTokenKeyID returns the ID of the key GitHub tokens are currently encrypted with
+func PrimaryTokenKeyID() string {
+	if tokenKeyring == nil {
+		return ""
+	}
+
+	return tokenKeyring.PrimaryKeyID()
+}
+
+// Implementing `sql.Scanner` interface to read and decrypt JSON from the database
 func (j *GitHubToken) Scan(value interface{}) error {
 	if value == nil {
 		*j = GitHubToken{}
@@ -27,10 +47,55 @@ func (j *GitHubToken) Scan(value interface{}) error {
 	if !ok {
 		return fmt.Errorf("failed to unmarshal JSON: %v", value)
 	}
-	return json.Unmarshal(bytes, j)
+
+	var tokenEnvelope envelope.Envelope
+	if err := json.Unmarshal(bytes, &tokenEnvelope); err != nil {
+		return err
+	}
+
+	// Tokens stored before encryption are plain JSON, they are encrypted the next time they are written
+	if tokenEnvelope.KeyID == "" {
+		return json.Unmarshal(bytes, j)
+	}
+
+	// A token that can't be decrypted must not make its whole row unreadable, the user is read without a token instead and
+	// logging in again stores a new one
+	if tokenKeyring == nil {
+		slog.Error(fmt.Sprintf("No keyring to decrypt the GitHub token encrypted with key %s", tokenEnvelope.KeyID))
+		*j = GitHubToken{}
+		return nil
+	}
+
+	plaintext, err := tokenKeyring.Decrypt(&tokenEnvelope)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to decrypt the GitHub token encrypted with key %s: %s", tokenEnvelope.KeyID, err.Error()))
+		*j = GitHubToken{}
+		return nil
+	}
+
+	return json.Unmarshal(plaintext, j)
 }
 
-// Implementing `driver.Valuer` interface to write JSON to the database
+// Implementing `driver.Valuer` interface to write encrypted JSON to the database
 func (j GitHubToken) Value() (driver.Value, error) {
-	return json.Marshal(j)
+	// Users that didn't link a GitHub account have no token
+	if j.AccessToken == "" && j.RefreshToken == "" {
+		return nil, nil
+	}
+
+	if tokenKeyring == nil {
+		return nil, errors.New("no keyring to encrypt the GitHub token")
+	}
+
+	plaintext, err := json.Marshal(j)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenEnvelope, err := tokenKeyring.Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(tokenEnvelope)
 }
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/benjasper/releases.one/pkg/envelope"
+)
+
+func setTestTokenKeyring(t *testing.T, spec string) {
+	keyring, err := envelope.ParseKeyring(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetTokenKeyring(keyring)
+	t.Cleanup(func() { SetTokenKeyring(nil) })
+}
+
+func TestGitHubToken_ValueScan(t *testing.T) {
+	setTestTokenKeyring(t, "1:"+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+
+	value, err := GitHubToken{AccessToken: "gho_access", RefreshToken: "ghr_refresh"}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored := value.([]byte)
+	if bytes.Contains(stored, []byte("gho_access")) || bytes.Contains(stored, []byte("ghr_refresh")) {
+		t.Errorf("stored token isn't encrypted: %s", stored)
+	}
+
+	var token GitHubToken
+	if err := token.Scan(stored); err != nil {
+		t.Fatal(err)
+	}
+
+	if token.AccessToken != "gho_access" || token.RefreshToken != "ghr_refresh" {
+		t.Errorf("unexpected token after decryption: %+v", token)
+	}
+}
+
+func TestGitHubToken_ScanPlaintext(t *testing.T) {
+	setTestTokenKeyring(t, "1:"+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+
+	var token GitHubToken
+	if err := token.Scan([]byte(`{"access_token":"gho_access","token_type":"bearer"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if token.AccessToken != "gho_access" {
+		t.Errorf("expected gho_access, got %q", token.AccessToken)
+	}
+}
+
+func TestGitHubToken_ValueEmpty(t *testing.T) {
+	value, err := GitHubToken{}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value != nil {
+		t.Errorf("expected users without a token to store NULL, got %v", value)
+	}
+}
+
+func TestGitHubToken_ScanUndecryptable(t *testing.T) {
+	setTestTokenKeyring(t, "1:"+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+
+	value, err := GitHubToken{AccessToken: "gho_access"}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The key the token was encrypted with was removed since
+	setTestTokenKeyring(t, "2:"+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)))
+
+	token := GitHubToken{AccessToken: "previous"}
+	if err := token.Scan(value); err != nil {
+		t.Fatalf("expected the row to stay readable, got %s", err)
+	}
+
+	if token.AccessToken != "" {
+		t.Errorf("expected no token, got %q", token.AccessToken)
+	}
+
+	SetTokenKeyring(nil)
+	if err := token.Scan(value); err != nil || token.AccessToken != "" {
+		t.Errorf("expected no token and no error without a keyring, got %q and %v", token.AccessToken, err)
+	}
+}
@@ -23,10 +23,12 @@ INSERT INTO
     last_synced_at,
     is_public,
 	is_onboarded,
-    public_id
+    public_id,
+    github_scopes,
+    sync_private
   )
 VALUES
-  (?, ?, ?, ?, ?, ?, ?);
+  (?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: UpdateUserToken :exec
 UPDATE users
@@ -35,6 +37,29 @@ SET
 WHERE
   id = ?;
 
+-- name: LinkUserGithub :execresult
+UPDATE users
+SET
+  github_id = ?
+WHERE
+  id = ?
+  AND github_id IS NULL;
+
+-- name: UpdateUserGithubScopes :exec
+UPDATE users
+SET
+  github_scopes = ?,
+  sync_private = ?
+WHERE
+  id = ?;
+
+-- name: UpdateUserSyncPrivate :exec
+UPDATE users
+SET
+  sync_private = ?
+WHERE
+  id = ?;
+
 -- name: UpdateUserIsPublic :exec
 UPDATE users
 SET
@@ -64,13 +89,29 @@ FROM
 WHERE
   public_id = ?;
 
+-- name: GetUserByPrivateFeedID :one
+SELECT
+  *
+FROM
+  users
+WHERE
+  private_feed_id = ?;
+
+-- name: UpdateUserPrivateFeedID :exec
+UPDATE users
+SET
+  private_feed_id = ?
+WHERE
+  id = ?;
+
 -- name: GetUsersInNeedOfAnUpdate :many
 SELECT
   *
 FROM
   users
 WHERE
-  last_synced_at < ?
+  github_id IS NOT NULL
+  AND last_synced_at < ?
 ORDER BY
   last_synced_at DESC
 LIMIT
@@ -115,6 +156,74 @@ SET
 WHERE
   id = ?;
 
+-- name: GetRepositoriesDueForSync :many
+SELECT
+  *
+FROM
+  repositories
+WHERE
+  (
+    next_sync_at IS NULL
+    OR next_sync_at <= sqlc.arg('now')
+  )
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      repository_stars
+  )
+ORDER BY
+  next_sync_at ASC
+LIMIT
+  ?;
+
+-- name: GetRepositoriesDueForSyncForUser :many
+SELECT
+  *
+FROM
+  repositories
+WHERE
+  (
+    next_sync_at IS NULL
+    OR next_sync_at <= sqlc.arg('now')
+  )
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      repository_stars
+    WHERE
+      user_id = sqlc.arg('user_id')
+  );
+
+-- name: GetRepositorySyncUser :one
+SELECT
+  *
+FROM
+  users
+WHERE
+  github_token IS NOT NULL
+  AND id IN (
+    SELECT
+      user_id
+    FROM
+      repository_stars
+    WHERE
+      repository_id = sqlc.arg('repository_id')
+  )
+ORDER BY
+  RAND()
+LIMIT
+  1;
+
+-- name: UpdateRepositorySyncSchedule :exec
+UPDATE repositories
+SET
+  last_synced_at = ?,
+  next_sync_at = ?
+WHERE
+  id = ?;
+
 -- name: FindRepositoriesByUser :many
 SELECT
   *
@@ -125,6 +234,15 @@ LEFT JOIN
 WHERE
   user_id = ?;
 
+-- name: GetRepositoryStar :one
+SELECT
+  *
+FROM
+  repository_stars
+WHERE
+  repository_id = ?
+  AND user_id = ?;
+
 -- name: InsertRepositoryStar :exec
 INSERT INTO
   repository_stars (repository_id, user_id, type, created_at, updated_at)
@@ -139,12 +257,67 @@ WHERE
   repository_id = ?
   AND user_id = ?;
 
+-- name: UpdateRepositoryStarMuted :execresult
+UPDATE repository_stars
+SET
+  is_muted = ?
+WHERE
+  repository_id = ?
+  AND user_id = ?;
+
+-- name: UpdateRepositoryStarSnooze :execresult
+UPDATE repository_stars
+SET
+  snoozed_until = ?,
+  snoozed_until_major = ?
+WHERE
+  repository_id = ?
+  AND user_id = ?;
+
+-- name: ClearRepositoryStarSnoozesBeforeMajor :exec
+UPDATE repository_stars
+SET
+  snoozed_until_major = NULL
+WHERE
+  repository_id = ?
+  AND snoozed_until_major < ?;
+
+-- name: GetMutedRepositoriesForUser :many
+SELECT
+  `repositories`.`id`,
+  `repositories`.`name`,
+  `repositories`.`url`,
+  `repositories`.`image_url`,
+  `repository_stars`.`is_muted`,
+  `repository_stars`.`snoozed_until`,
+  `repository_stars`.`snoozed_until_major`
+FROM
+  `repository_stars`
+  INNER JOIN `repositories` ON `repository_stars`.`repository_id` = `repositories`.`id`
+WHERE
+  `repository_stars`.`user_id` = ?
+  AND (
+    `repository_stars`.`is_muted` = true
+    OR `repository_stars`.`snoozed_until` IS NOT NULL
+    OR `repository_stars`.`snoozed_until_major` IS NOT NULL
+  )
+ORDER BY
+  `repositories`.`name` ASC;
+
 -- name: DeleteRepositoryStarsUpdatedBefore :execresult
 DELETE FROM repository_stars
 WHERE
   updated_at < ?
   AND user_id = ?;
 
+-- name: GetReleaseByID :one
+SELECT
+  *
+FROM
+  releases
+WHERE
+  id = ?;
+
 -- name: GetReleases :many
 SELECT
   *
@@ -155,7 +328,7 @@ WHERE
 ORDER BY
   released_at DESC;
 
--- name: InsertRelease :exec
+-- name: InsertRelease :execresult
 INSERT INTO
   releases (
     github_id,
@@ -166,36 +339,100 @@ INSERT INTO
     url,
     description,
     description_short,
+    description_text,
     hash,
+    is_security,
+    is_breaking,
+    has_deprecation,
+    has_migration_guide,
+    vulnerability_ids,
+    signals_detected,
+    major_version,
     released_at,
+    github_created_at,
+    first_seen_at,
+    is_backfill,
     created_at,
     updated_at,
     is_prerelease
   )
 VALUES
-  (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+  (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: UpdateRelease :execresult
 UPDATE releases
 SET
   github_id = ?,
+  tag_name = ?,
   name = ?,
   url = ?,
   description = ?,
   description_short = ?,
+  description_text = ?,
   author = ?,
   is_prerelease = ?,
+  is_security = ? OR EXISTS (
+    SELECT 1 FROM release_advisories WHERE release_advisories.release_id = releases.id
+  ),
+  is_breaking = ?,
+  has_deprecation = ?,
+  has_migration_guide = ?,
+  vulnerability_ids = ?,
+  signals_detected = true,
+  major_version = ?,
   released_at = ?,
+  github_created_at = ?,
+  edited_at = ?,
+  retracted_at = NULL,
   updated_at = ?,
   hash = ?
 WHERE
   id = ?;
 
+-- name: BackfillReleasesFirstSeen :execresult
+UPDATE releases
+SET
+  first_seen_at = created_at,
+  is_backfill = true
+WHERE
+  first_seen_at IS NULL
+LIMIT
+  ?;
+
+-- name: GetReleasesWithoutSignals :many
+SELECT
+  id,
+  description
+FROM
+  releases
+WHERE
+  signals_detected = false
+  AND id > sqlc.arg('after_id')
+ORDER BY
+  id ASC
+LIMIT
+  ?;
+
+-- name: UpdateReleaseSignals :exec
+UPDATE releases
+SET
+  is_security = ? OR EXISTS (
+    SELECT 1 FROM release_advisories WHERE release_advisories.release_id = releases.id
+  ),
+  is_breaking = ?,
+  has_deprecation = ?,
+  has_migration_guide = ?,
+  vulnerability_ids = ?,
+  signals_detected = true
+WHERE
+  id = ?;
+
 -- name: DeleteReleasesOlderThan :execresult
 DELETE FROM releases
 WHERE
   released_at < ?
   AND repository_id = ?
+  AND id NOT IN (SELECT release_id FROM bookmarks)
 ORDER BY
   released_at DESC;
 
@@ -211,7 +448,16 @@ SELECT
   `releases`.`description_short`,
   `releases`.`author`,
   `releases`.`is_prerelease`,
+  `releases`.`is_security`,
+  `releases`.`is_breaking`,
+  `releases`.`has_deprecation`,
+  `releases`.`has_migration_guide`,
+  `releases`.`vulnerability_ids`,
   `releases`.`released_at`,
+  `releases`.`edited_at`,
+  `releases`.`retracted_at`,
+  `releases`.`first_seen_at`,
+  `releases`.`is_backfill`,
   `releases`.`created_at`,
   `releases`.`updated_at`,
   `repositories`.`name` AS repository_name,
@@ -227,9 +473,32 @@ FROM
 WHERE
   `repository_stars`.`user_id` = ?
   AND `users`.`is_public` = true
+  AND `repositories`.`private` = false
   AND (sqlc.narg('is_prerelease') IS NULL OR `is_prerelease` = sqlc.narg('is_prerelease'))
   AND (sqlc.narg('star_type') IS NULL OR `repository_stars`.`type` = sqlc.narg('star_type'))
+  AND (sqlc.narg('is_security') IS NULL OR `releases`.`is_security` = sqlc.narg('is_security'))
+  AND (sqlc.narg('is_breaking') IS NULL OR `releases`.`is_breaking` = sqlc.narg('is_breaking'))
+  AND (
+    sqlc.narg('group_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `repository_group_members`.`repository_id`
+      FROM `repository_group_members`
+      WHERE `repository_group_members`.`group_id` = sqlc.narg('group_id')
+    )
+  )
+  AND (
+    sqlc.narg('list_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `star_list_repositories`.`repository_id`
+      FROM `star_list_repositories`
+      WHERE `star_list_repositories`.`list_id` = sqlc.narg('list_id')
+    )
+  )
+  AND `repository_stars`.`is_muted` = false
+  AND (`repository_stars`.`snoozed_until` IS NULL OR `repository_stars`.`snoozed_until` <= sqlc.arg('now'))
+  AND (`repository_stars`.`snoozed_until_major` IS NULL OR `releases`.`major_version` IS NULL OR `releases`.`major_version` > `repository_stars`.`snoozed_until_major`)
 ORDER BY
+  releases.first_seen_at DESC,
   releases.released_at DESC
 LIMIT
   100;
@@ -245,23 +514,954 @@ SELECT
   `releases`.`description_short`,
   `releases`.`author`,
   `releases`.`is_prerelease`,
+  `releases`.`is_security`,
+  `releases`.`is_breaking`,
+  `releases`.`has_deprecation`,
+  `releases`.`has_migration_guide`,
+  `releases`.`vulnerability_ids`,
   `releases`.`released_at`,
+  `releases`.`edited_at`,
+  `releases`.`retracted_at`,
+  `releases`.`first_seen_at`,
+  `releases`.`is_backfill`,
   `releases`.`created_at`,
   `releases`.`updated_at`,
   `repositories`.`name` AS repository_name,
   `repositories`.`image_url` AS image_url,
   `repositories`.`image_size` AS image_size,
   `repositories`.`url` AS repository_url,
-  `repository_stars`.`type` AS repository_star_type
+  `repository_stars`.`type` AS repository_star_type,
+  `repository_stars`.`created_at` AS starred_at,
+  `release_reads`.`created_at` AS read_at
 FROM
   `releases`
   LEFT JOIN `repositories` ON `releases`.`repository_id` = `repositories`.`id`
   INNER JOIN `repository_stars` ON `releases`.`repository_id` = `repository_stars`.`repository_id`
+  INNER JOIN `users` ON `repository_stars`.`user_id` = `users`.`id`
+  LEFT JOIN `release_reads` ON `release_reads`.`release_id` = `releases`.`id` AND `release_reads`.`user_id` = `users`.`id`
 WHERE
   `repository_stars`.`user_id` = ?
   AND (sqlc.narg('is_prerelease') IS NULL OR `is_prerelease` = sqlc.narg('is_prerelease'))
   AND (sqlc.narg('star_type') IS NULL OR `repository_stars`.`type` = sqlc.narg('star_type'))
+  AND (sqlc.narg('is_security') IS NULL OR `releases`.`is_security` = sqlc.narg('is_security'))
+  AND (sqlc.narg('is_breaking') IS NULL OR `releases`.`is_breaking` = sqlc.narg('is_breaking'))
+  AND (
+    sqlc.narg('group_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `repository_group_members`.`repository_id`
+      FROM `repository_group_members`
+      WHERE `repository_group_members`.`group_id` = sqlc.narg('group_id')
+    )
+  )
+  AND (
+    sqlc.narg('list_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `star_list_repositories`.`repository_id`
+      FROM `star_list_repositories`
+      WHERE `star_list_repositories`.`list_id` = sqlc.narg('list_id')
+    )
+  )
+  AND `repository_stars`.`is_muted` = false
+  AND (`repository_stars`.`snoozed_until` IS NULL OR `repository_stars`.`snoozed_until` <= sqlc.arg('now'))
+  AND (`repository_stars`.`snoozed_until_major` IS NULL OR `releases`.`major_version` IS NULL OR `releases`.`major_version` > `repository_stars`.`snoozed_until_major`)
+  AND (
+    sqlc.arg('unread_only') = false
+    OR (
+      `release_reads`.`release_id` IS NULL
+      AND `releases`.`is_backfill` = false
+      AND `releases`.`first_seen_at` >= `repository_stars`.`created_at`
+      AND (`users`.`read_all_at` IS NULL OR `releases`.`first_seen_at` > `users`.`read_all_at`)
+    )
+  )
+  AND (
+    sqlc.narg('new_since') IS NULL
+    OR (
+      `releases`.`first_seen_at` > sqlc.narg('new_since')
+      AND `releases`.`is_backfill` = false
+      AND `releases`.`first_seen_at` >= `repository_stars`.`created_at`
+    )
+  )
 ORDER BY
   releases.released_at DESC
 LIMIT
   100;
+
+-- name: CountUnreadReleasesForUser :one
+SELECT
+  COUNT(*)
+FROM
+  `releases`
+  INNER JOIN `repository_stars` ON `releases`.`repository_id` = `repository_stars`.`repository_id`
+  INNER JOIN `users` ON `repository_stars`.`user_id` = `users`.`id`
+  LEFT JOIN `release_reads` ON `release_reads`.`release_id` = `releases`.`id` AND `release_reads`.`user_id` = `users`.`id`
+WHERE
+  `repository_stars`.`user_id` = ?
+  AND (sqlc.narg('is_prerelease') IS NULL OR `is_prerelease` = sqlc.narg('is_prerelease'))
+  AND (sqlc.narg('star_type') IS NULL OR `repository_stars`.`type` = sqlc.narg('star_type'))
+  AND (sqlc.narg('is_security') IS NULL OR `releases`.`is_security` = sqlc.narg('is_security'))
+  AND (sqlc.narg('is_breaking') IS NULL OR `releases`.`is_breaking` = sqlc.narg('is_breaking'))
+  AND (
+    sqlc.narg('group_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `repository_group_members`.`repository_id`
+      FROM `repository_group_members`
+      WHERE `repository_group_members`.`group_id` = sqlc.narg('group_id')
+    )
+  )
+  AND (
+    sqlc.narg('list_id') IS NULL
+    OR `releases`.`repository_id` IN (
+      SELECT `star_list_repositories`.`repository_id`
+      FROM `star_list_repositories`
+      WHERE `star_list_repositories`.`list_id` = sqlc.narg('list_id')
+    )
+  )
+  AND `repository_stars`.`is_muted` = false
+  AND (`repository_stars`.`snoozed_until` IS NULL OR `repository_stars`.`snoozed_until` <= sqlc.arg('now'))
+  AND (`repository_stars`.`snoozed_until_major` IS NULL OR `releases`.`major_version` IS NULL OR `releases`.`major_version` > `repository_stars`.`snoozed_until_major`)
+  AND `release_reads`.`release_id` IS NULL
+  AND `releases`.`is_backfill` = false
+  AND `releases`.`first_seen_at` >= `repository_stars`.`created_at`
+  AND (`users`.`read_all_at` IS NULL OR `releases`.`first_seen_at` > `users`.`read_all_at`);
+
+-- name: InsertReleaseRead :exec
+INSERT IGNORE INTO
+  release_reads (user_id, release_id, created_at)
+VALUES
+  (?, ?, ?);
+
+-- name: InsertRepositoryReleaseReads :exec
+INSERT IGNORE INTO
+  release_reads (user_id, release_id, created_at)
+SELECT
+  repository_stars.user_id,
+  releases.id,
+  sqlc.arg('created_at')
+FROM
+  releases
+  INNER JOIN repository_stars ON repository_stars.repository_id = releases.repository_id
+WHERE
+  releases.repository_id = sqlc.arg('repository_id')
+  AND repository_stars.user_id = sqlc.arg('user_id');
+
+-- name: UpdateUserReadAllAt :exec
+UPDATE users
+SET
+  read_all_at = ?
+WHERE
+  id = ?;
+
+-- name: DeleteReleaseReadsCreatedBefore :exec
+DELETE FROM release_reads
+WHERE
+  created_at <= ?
+  AND user_id = ?;
+
+-- name: UpsertBookmark :exec
+INSERT INTO
+  bookmarks (user_id, release_id, note, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  note = VALUES(note),
+  updated_at = VALUES(updated_at);
+
+-- name: DeleteBookmark :execresult
+DELETE FROM bookmarks
+WHERE
+  user_id = ?
+  AND release_id = ?;
+
+-- name: GetBookmarksForUser :many
+SELECT
+  `releases`.`id`,
+  `releases`.`github_id`,
+  `releases`.`repository_id`,
+  `releases`.`name`,
+  `releases`.`url`,
+  `releases`.`tag_name`,
+  `releases`.`description`,
+  `releases`.`description_short`,
+  `releases`.`author`,
+  `releases`.`is_prerelease`,
+  `releases`.`is_security`,
+  `releases`.`is_breaking`,
+  `releases`.`has_deprecation`,
+  `releases`.`has_migration_guide`,
+  `releases`.`vulnerability_ids`,
+  `releases`.`released_at`,
+  `releases`.`first_seen_at`,
+  `releases`.`is_backfill`,
+  `repositories`.`name` AS repository_name,
+  `repositories`.`image_url` AS image_url,
+  `repositories`.`github_id` AS repository_github_id,
+  `repositories`.`url` AS repository_url,
+  `bookmarks`.`note`,
+  `bookmarks`.`created_at` AS bookmarked_at,
+  `bookmarks`.`updated_at` AS bookmark_updated_at
+FROM
+  `bookmarks`
+  INNER JOIN `releases` ON `bookmarks`.`release_id` = `releases`.`id`
+  INNER JOIN `repositories` ON `releases`.`repository_id` = `repositories`.`id`
+  LEFT JOIN `repository_stars` ON `repository_stars`.`repository_id` = `repositories`.`id` AND `repository_stars`.`user_id` = `bookmarks`.`user_id`
+WHERE
+  `bookmarks`.`user_id` = ?
+  AND (
+    `repositories`.`private` = false
+    OR `repository_stars`.`user_id` IS NOT NULL
+  )
+ORDER BY
+  `bookmarks`.`created_at` DESC;
+
+-- name: GetRepositoryGroupsForUser :many
+SELECT
+  *
+FROM
+  repository_groups
+WHERE
+  user_id = ?
+ORDER BY
+  name ASC;
+
+-- name: GetRepositoryGroup :one
+SELECT
+  *
+FROM
+  repository_groups
+WHERE
+  id = ?
+  AND user_id = ?;
+
+-- name: GetRepositoryGroupByName :one
+SELECT
+  *
+FROM
+  repository_groups
+WHERE
+  user_id = ?
+  AND name = ?;
+
+-- name: CreateRepositoryGroup :execresult
+INSERT INTO
+  repository_groups (user_id, name, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?);
+
+-- name: UpdateRepositoryGroupName :execresult
+UPDATE repository_groups
+SET
+  name = ?,
+  updated_at = ?
+WHERE
+  id = ?
+  AND user_id = ?;
+
+-- name: DeleteRepositoryGroup :execresult
+DELETE FROM repository_groups
+WHERE
+  id = ?
+  AND user_id = ?;
+
+-- name: GetRepositoryGroupMembersForUser :many
+SELECT
+  `repository_group_members`.`group_id`,
+  `repository_group_members`.`repository_id`
+FROM
+  `repository_group_members`
+  INNER JOIN `repository_groups` ON `repository_group_members`.`group_id` = `repository_groups`.`id`
+WHERE
+  `repository_groups`.`user_id` = ?;
+
+-- name: InsertRepositoryGroupMember :exec
+INSERT IGNORE INTO
+  repository_group_members (group_id, repository_id, created_at)
+VALUES
+  (?, ?, ?);
+
+-- name: DeleteRepositoryGroupMember :execresult
+DELETE FROM repository_group_members
+WHERE
+  group_id = ?
+  AND repository_id = ?;
+
+-- name: GetRepositoryIDsByGithubIDs :many
+SELECT
+  id,
+  github_id
+FROM
+  repositories
+WHERE
+  github_id IN (sqlc.slice('github_ids'));
+
+-- name: GetStarListByGithubID :one
+SELECT
+  *
+FROM
+  star_lists
+WHERE
+  github_id = ?
+  AND user_id = ?;
+
+-- name: GetStarListBySlug :one
+SELECT
+  *
+FROM
+  star_lists
+WHERE
+  user_id = ?
+  AND slug = ?;
+
+-- name: GetStarList :one
+SELECT
+  *
+FROM
+  star_lists
+WHERE
+  id = ?
+  AND user_id = ?;
+
+-- name: GetStarListsForUser :many
+SELECT
+  *
+FROM
+  star_lists
+WHERE
+  user_id = ?
+ORDER BY
+  name ASC;
+
+-- name: InsertStarList :execresult
+INSERT INTO
+  star_lists (user_id, github_id, name, slug, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?, ?);
+
+-- name: UpdateStarList :exec
+UPDATE star_lists
+SET
+  name = ?,
+  slug = ?,
+  updated_at = ?
+WHERE
+  id = ?;
+
+-- name: DeleteStarListsUpdatedBefore :execresult
+DELETE FROM star_lists
+WHERE
+  updated_at < ?
+  AND user_id = ?;
+
+-- name: UpsertStarListRepository :exec
+INSERT INTO
+  star_list_repositories (list_id, repository_id, updated_at)
+VALUES
+  (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  updated_at = VALUES(updated_at);
+
+-- name: DeleteStarListRepositoriesUpdatedBefore :exec
+DELETE FROM star_list_repositories
+WHERE
+  updated_at < ?
+  AND list_id = ?;
+
+-- name: GetStarListRepositoriesForUser :many
+SELECT
+  `star_list_repositories`.`list_id`,
+  `star_list_repositories`.`repository_id`
+FROM
+  `star_list_repositories`
+  INNER JOIN `star_lists` ON `star_list_repositories`.`list_id` = `star_lists`.`id`
+WHERE
+  `star_lists`.`user_id` = ?;
+
+-- name: SearchReleasesForUser :many
+SELECT
+  `releases`.`id`,
+  `releases`.`repository_id`,
+  `releases`.`name`,
+  `releases`.`url`,
+  `releases`.`tag_name`,
+  `releases`.`description_short`,
+  `releases`.`description_text`,
+  `releases`.`author`,
+  `releases`.`is_prerelease`,
+  `releases`.`is_security`,
+  `releases`.`is_breaking`,
+  `releases`.`has_deprecation`,
+  `releases`.`has_migration_guide`,
+  `releases`.`vulnerability_ids`,
+  `releases`.`released_at`,
+  `releases`.`first_seen_at`,
+  `releases`.`is_backfill`,
+  `repositories`.`name` AS repository_name,
+  `repositories`.`image_url` AS image_url,
+  `repositories`.`url` AS repository_url,
+  `repository_stars`.`type` AS repository_star_type
+FROM
+  `releases`
+  INNER JOIN `repositories` ON `releases`.`repository_id` = `repositories`.`id`
+  INNER JOIN `repository_stars` ON `releases`.`repository_id` = `repository_stars`.`repository_id`
+WHERE
+  `repository_stars`.`user_id` = ?
+  AND MATCH (`releases`.`name`, `releases`.`tag_name`, `releases`.`description_text`) AGAINST (sqlc.arg('query') IN BOOLEAN MODE)
+  AND (sqlc.narg('is_prerelease') IS NULL OR `is_prerelease` = sqlc.narg('is_prerelease'))
+  AND (sqlc.narg('repository_id') IS NULL OR `releases`.`repository_id` = sqlc.narg('repository_id'))
+  AND (sqlc.narg('released_after') IS NULL OR `releases`.`released_at` >= sqlc.narg('released_after'))
+  AND (sqlc.narg('released_before') IS NULL OR `releases`.`released_at` <= sqlc.narg('released_before'))
+ORDER BY
+  MATCH (`releases`.`name`, `releases`.`tag_name`, `releases`.`description_text`) AGAINST (sqlc.arg('query') IN BOOLEAN MODE) DESC,
+  `releases`.`released_at` DESC
+LIMIT
+  50;
+
+-- name: UpdateRepositoryAdvisoriesSyncedAt :exec
+UPDATE repositories
+SET
+  advisories_synced_at = ?
+WHERE
+  id = ?;
+
+-- name: UpsertReleaseAdvisory :exec
+INSERT INTO
+  release_advisories (release_id, ghsa_id, cve_id, severity, summary, url, published_at, created_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  cve_id = VALUES(cve_id),
+  severity = VALUES(severity),
+  summary = VALUES(summary),
+  url = VALUES(url);
+
+-- name: MarkReleaseSecurity :exec
+UPDATE releases
+SET
+  is_security = true
+WHERE
+  id = ?;
+
+-- name: GetAdvisoriesForReleases :many
+SELECT
+  *
+FROM
+  release_advisories
+WHERE
+  release_id IN (sqlc.slice('release_ids'))
+ORDER BY
+  published_at DESC;
+
+-- name: UpsertReleaseAsset :exec
+INSERT INTO
+  release_assets (release_id, github_id, name, content_type, size, download_count, url, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  name = VALUES(name),
+  content_type = VALUES(content_type),
+  size = VALUES(size),
+  download_count = VALUES(download_count),
+  url = VALUES(url),
+  updated_at = VALUES(updated_at);
+
+-- name: DeleteReleaseAssetsUpdatedBefore :exec
+DELETE FROM release_assets
+WHERE
+  updated_at < ?
+  AND release_id = ?;
+
+-- name: GetReleaseAssets :many
+SELECT
+  *
+FROM
+  release_assets
+WHERE
+  release_id = ?
+ORDER BY
+  name ASC;
+
+-- name: GetAssetsForReleases :many
+SELECT
+  *
+FROM
+  release_assets
+WHERE
+  release_id IN (sqlc.slice('release_ids'))
+ORDER BY
+  name ASC;
+
+-- name: GetReleaseForUser :one
+SELECT
+  `releases`.`id`,
+  `releases`.`github_id`,
+  `releases`.`repository_id`,
+  `releases`.`name`,
+  `releases`.`url`,
+  `releases`.`tag_name`,
+  `releases`.`description`,
+  `releases`.`description_short`,
+  `releases`.`author`,
+  `releases`.`is_prerelease`,
+  `releases`.`is_security`,
+  `releases`.`is_breaking`,
+  `releases`.`has_deprecation`,
+  `releases`.`has_migration_guide`,
+  `releases`.`vulnerability_ids`,
+  `releases`.`released_at`,
+  `releases`.`edited_at`,
+  `releases`.`retracted_at`,
+  `releases`.`first_seen_at`,
+  `releases`.`is_backfill`,
+  `repositories`.`name` AS repository_name,
+  `repositories`.`image_url` AS image_url,
+  `repositories`.`url` AS repository_url,
+  `repository_stars`.`type` AS repository_star_type
+FROM
+  `releases`
+  INNER JOIN `repositories` ON `releases`.`repository_id` = `repositories`.`id`
+  INNER JOIN `repository_stars` ON `releases`.`repository_id` = `repository_stars`.`repository_id`
+WHERE
+  `releases`.`id` = ?
+  AND `repository_stars`.`user_id` = ?;
+
+-- name: GetPreviousRelease :one
+SELECT
+  *
+FROM
+  releases
+WHERE
+  repository_id = ?
+  AND retracted_at IS NULL
+  AND (
+    released_at < sqlc.arg('released_at')
+    OR (released_at = sqlc.arg('released_at') AND id < sqlc.arg('id'))
+  )
+ORDER BY
+  released_at DESC,
+  id DESC
+LIMIT
+  1;
+
+-- name: GetNextRelease :one
+SELECT
+  *
+FROM
+  releases
+WHERE
+  repository_id = ?
+  AND retracted_at IS NULL
+  AND (
+    released_at > sqlc.arg('released_at')
+    OR (released_at = sqlc.arg('released_at') AND id > sqlc.arg('id'))
+  )
+ORDER BY
+  released_at ASC,
+  id ASC
+LIMIT
+  1;
+
+-- name: UpdateUserReleaseHistory :exec
+UPDATE users
+SET
+  release_fetch_depth = ?,
+  release_retention = ?
+WHERE
+  id = ?;
+
+-- name: GetReleaseRetentionsForRepository :many
+SELECT
+  `users`.`release_retention`
+FROM
+  `repository_stars`
+  INNER JOIN `users` ON `repository_stars`.`user_id` = `users`.`id`
+WHERE
+  `repository_stars`.`repository_id` = ?;
+
+-- name: GetReleaseFetchDepthsForRepository :many
+SELECT
+  `users`.`release_fetch_depth`
+FROM
+  `repository_stars`
+  INNER JOIN `users` ON `repository_stars`.`user_id` = `users`.`id`
+WHERE
+  `repository_stars`.`repository_id` = ?;
+
+-- name: InsertReleaseEdit :exec
+INSERT INTO
+  release_edits (release_id, name, tag_name, description, is_prerelease, edited_at, diff)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetReleaseEdits :many
+SELECT
+  *
+FROM
+  release_edits
+WHERE
+  release_id = ?
+ORDER BY
+  edited_at DESC;
+
+-- name: RetractRelease :exec
+UPDATE releases
+SET
+  retracted_at = ?
+WHERE
+  id = ?
+  AND retracted_at IS NULL;
+
+-- name: GetReleaseEdit :one
+SELECT
+  *
+FROM
+  release_edits
+WHERE
+  id = ?
+  AND release_id = ?;
+
+-- name: GetNewerReleaseEdit :one
+SELECT
+  *
+FROM
+  release_edits
+WHERE
+  release_id = sqlc.arg('release_id')
+  AND (
+    edited_at > sqlc.arg('edited_at')
+    OR (
+      edited_at = sqlc.arg('edited_at')
+      AND id > sqlc.arg('id')
+    )
+  )
+ORDER BY
+  edited_at ASC,
+  id ASC
+LIMIT
+  1;
+
+-- name: GetReleaseEditsForReleases :many
+SELECT
+  *
+FROM
+  release_edits
+WHERE
+  release_id IN (sqlc.slice('release_ids'))
+ORDER BY
+  edited_at DESC,
+  id DESC;
+
+-- name: DeletePrivateRepositoryStarsForUser :execresult
+DELETE FROM repository_stars
+WHERE
+  user_id = ?
+  AND repository_id IN (
+    SELECT
+      id
+    FROM
+      repositories
+    WHERE
+      private = true
+  );
+
+-- name: GetUsersWithTokenKeyOtherThan :many
+SELECT
+  id,
+  username
+FROM
+  users
+WHERE
+  github_token IS NOT NULL
+  AND COALESCE(JSON_UNQUOTE(JSON_EXTRACT(github_token, '$.key_id')), '') != sqlc.arg('key_id')
+  AND id > sqlc.arg('after_id')
+ORDER BY
+  id ASC
+LIMIT
+  ?;
+
+-- name: CreateSession :exec
+INSERT INTO
+  sessions (id, user_id, refresh_token_id, user_agent, ip_address, created_at, last_used_at, expires_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetActiveSession :one
+SELECT
+  *
+FROM
+  sessions
+WHERE
+  id = sqlc.arg('id')
+  AND revoked_at IS NULL
+  AND expires_at > sqlc.arg('now');
+
+-- name: GetActiveSessionsForUser :many
+SELECT
+  *
+FROM
+  sessions
+WHERE
+  user_id = sqlc.arg('user_id')
+  AND revoked_at IS NULL
+  AND expires_at > sqlc.arg('now')
+ORDER BY
+  last_used_at DESC;
+
+-- name: RotateSessionRefreshToken :execresult
+UPDATE sessions
+SET
+  previous_refresh_token_id = refresh_token_id,
+  refresh_token_id = sqlc.arg('refresh_token_id'),
+  rotated_at = sqlc.arg('rotated_at'),
+  user_agent = sqlc.arg('user_agent'),
+  ip_address = sqlc.arg('ip_address'),
+  last_used_at = sqlc.arg('rotated_at'),
+  expires_at = sqlc.arg('expires_at')
+WHERE
+  id = sqlc.arg('id')
+  AND refresh_token_id = sqlc.arg('current_refresh_token_id')
+  AND revoked_at IS NULL;
+
+-- name: RevokeSession :execresult
+UPDATE sessions
+SET
+  revoked_at = ?
+WHERE
+  id = ?
+  AND user_id = ?
+  AND revoked_at IS NULL;
+
+-- name: RevokeSessionsForUser :execresult
+UPDATE sessions
+SET
+  revoked_at = sqlc.arg('revoked_at')
+WHERE
+  user_id = sqlc.arg('user_id')
+  AND id != sqlc.arg('except_id')
+  AND revoked_at IS NULL;
+
+-- name: DeleteSessionsEndedBefore :execresult
+DELETE FROM sessions
+WHERE
+  expires_at < sqlc.arg('ended_before')
+  OR revoked_at < sqlc.arg('ended_before');
+
+-- name: CreateApiToken :execresult
+INSERT INTO
+  api_tokens (user_id, name, token_hash, token_prefix, scopes, created_at, expires_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetApiTokenByHash :one
+SELECT
+  *
+FROM
+  api_tokens
+WHERE
+  token_hash = ?;
+
+-- name: GetApiTokensForUser :many
+SELECT
+  *
+FROM
+  api_tokens
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC;
+
+-- name: TouchApiToken :exec
+UPDATE api_tokens
+SET
+  last_used_at = sqlc.arg('last_used_at')
+WHERE
+  id = sqlc.arg('id')
+  AND (
+    last_used_at IS NULL
+    OR last_used_at < sqlc.arg('touched_before')
+  );
+
+-- name: DeleteApiToken :execresult
+DELETE FROM api_tokens
+WHERE
+  id = ?
+  AND user_id = ?;
+
+-- name: GetUserIdentity :one
+SELECT
+  *
+FROM
+  user_identities
+WHERE
+  issuer = ?
+  AND subject = ?;
+
+-- name: CreateUserIdentity :exec
+INSERT INTO
+  user_identities (user_id, issuer, subject, email, created_at, last_login_at)
+VALUES
+  (?, ?, ?, ?, ?, ?);
+
+-- name: UpdateUserIdentityLogin :exec
+UPDATE user_identities
+SET
+  email = ?,
+  last_login_at = ?
+WHERE
+  id = ?;
+
+-- name: DeleteUser :exec
+DELETE FROM users
+WHERE
+  id = ?;
+
+-- name: GetStarsForUser :many
+SELECT
+  `repositories`.`id`,
+  `repositories`.`name`,
+  `repositories`.`url`,
+  `repository_stars`.`type`,
+  `repository_stars`.`is_muted`,
+  `repository_stars`.`snoozed_until`,
+  `repository_stars`.`snoozed_until_major`,
+  `repository_stars`.`created_at`
+FROM
+  `repository_stars`
+  INNER JOIN `repositories` ON `repository_stars`.`repository_id` = `repositories`.`id`
+WHERE
+  `repository_stars`.`user_id` = ?
+ORDER BY
+  `repositories`.`name` ASC;
+
+-- name: GetReleaseReadsForUser :many
+SELECT
+  `release_reads`.`release_id`,
+  `releases`.`repository_id`,
+  `releases`.`name`,
+  `releases`.`url`,
+  `release_reads`.`created_at`
+FROM
+  `release_reads`
+  INNER JOIN `releases` ON `release_reads`.`release_id` = `releases`.`id`
+WHERE
+  `release_reads`.`user_id` = ?
+ORDER BY
+  `release_reads`.`created_at` DESC;
+
+-- name: GetUserIdentitiesForUser :many
+SELECT
+  *
+FROM
+  user_identities
+WHERE
+  user_id = ?;
+
+-- name: GetSessionsForUser :many
+SELECT
+  *
+FROM
+  sessions
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC;
+
+-- name: DeleteUnfollowedRepositories :execresult
+DELETE FROM repositories
+WHERE
+  id IN (sqlc.slice('repository_ids'))
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
+
+-- name: MarkOrphanedRepositories :execresult
+UPDATE repositories
+SET
+  orphaned_at = sqlc.arg('orphaned_at')
+WHERE
+  orphaned_at IS NULL
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
+
+-- name: UnmarkFollowedRepositories :execresult
+UPDATE repositories
+SET
+  orphaned_at = NULL
+WHERE
+  orphaned_at IS NOT NULL
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
+
+-- name: CountOrphanedRepositoriesToMark :one
+SELECT
+  COUNT(*)
+FROM
+  repositories
+WHERE
+  orphaned_at IS NULL
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
+
+-- name: CountFollowedRepositoriesToUnmark :one
+SELECT
+  COUNT(*)
+FROM
+  repositories
+WHERE
+  orphaned_at IS NOT NULL
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
+
+-- name: GetRepositoriesOrphanedBefore :many
+SELECT
+  `repositories`.`id`,
+  `repositories`.`name`,
+  COUNT(`releases`.`id`) AS release_count
+FROM
+  `repositories`
+  LEFT JOIN `releases` ON `releases`.`repository_id` = `repositories`.`id`
+WHERE
+  `repositories`.`orphaned_at` < sqlc.arg('orphaned_before')
+  AND `repositories`.`id` > sqlc.arg('after_id')
+  AND `repositories`.`id` NOT IN (
+    SELECT
+      `repository_id`
+    FROM
+      `followed_repositories`
+  )
+GROUP BY
+  `repositories`.`id`,
+  `repositories`.`name`
+ORDER BY
+  `repositories`.`id` ASC
+LIMIT
+  ?;
+
+-- name: DeleteOrphanedRepository :execresult
+DELETE FROM repositories
+WHERE
+  id = sqlc.arg('id')
+  AND orphaned_at < sqlc.arg('orphaned_before')
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  );
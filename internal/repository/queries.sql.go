@@ -8,9 +8,190 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 )
 
+const backfillReleasesFirstSeen = `-- name: BackfillReleasesFirstSeen :execresult
+UPDATE releases
+SET
+  first_seen_at = created_at,
+  is_backfill = true
+WHERE
+  first_seen_at IS NULL
+LIMIT
+  ?
+`
+
+func (q *Queries) BackfillReleasesFirstSeen(ctx context.Context, limit int32) (sql.Result, error) {
+	return q.db.ExecContext(ctx, backfillReleasesFirstSeen, limit)
+}
+
+const clearRepositoryStarSnoozesBeforeMajor = `-- name: ClearRepositoryStarSnoozesBeforeMajor :exec
+UPDATE repository_stars
+SET
+  snoozed_until_major = NULL
+WHERE
+  repository_id = ?
+  AND snoozed_until_major < ?
+`
+
+type ClearRepositoryStarSnoozesBeforeMajorParams struct {
+	RepositoryID      int32
+	SnoozedUntilMajor sql.NullInt32
+}
+
+func (q *Queries) ClearRepositoryStarSnoozesBeforeMajor(ctx context.Context, arg ClearRepositoryStarSnoozesBeforeMajorParams) error {
+	_, err := q.db.ExecContext(ctx, clearRepositoryStarSnoozesBeforeMajor, arg.RepositoryID, arg.SnoozedUntilMajor)
+	return err
+}
+
+const countFollowedRepositoriesToUnmark = `-- name: CountFollowedRepositoriesToUnmark :one
+SELECT
+  COUNT(*)
+FROM
+  repositories
+WHERE
+  orphaned_at IS NOT NULL
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+func (q *Queries) CountFollowedRepositoriesToUnmark(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countFollowedRepositoriesToUnmark)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOrphanedRepositoriesToMark = `-- name: CountOrphanedRepositoriesToMark :one
+SELECT
+  COUNT(*)
+FROM
+  repositories
+WHERE
+  orphaned_at IS NULL
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+func (q *Queries) CountOrphanedRepositoriesToMark(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrphanedRepositoriesToMark)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadReleasesForUser = `-- name: CountUnreadReleasesForUser :one
+SELECT
+  COUNT(*)
+FROM
+  ` + "`" + `releases` + "`" + `
+  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+  LEFT JOIN ` + "`" + `release_reads` + "`" + ` ON ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + ` = ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + ` AND ` + "`" + `release_reads` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + ` = ?)
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `repository_group_members` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `repository_group_members` + "`" + `
+      WHERE ` + "`" + `repository_group_members` + "`" + `.` + "`" + `group_id` + "`" + ` = ?
+    )
+  )
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `star_list_repositories` + "`" + `
+      WHERE ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `list_id` + "`" + ` = ?
+    )
+  )
+  AND ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + ` = false
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` <= ?)
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` > ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + `)
+  AND ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + ` IS NULL
+  AND ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + ` = false
+  AND ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` >= ` + "`" + `repository_stars` + "`" + `.` + "`" + `created_at` + "`" + `
+  AND (` + "`" + `users` + "`" + `.` + "`" + `read_all_at` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` > ` + "`" + `users` + "`" + `.` + "`" + `read_all_at` + "`" + `)
+`
+
+type CountUnreadReleasesForUserParams struct {
+	UserID       int32
+	IsPrerelease sql.NullBool
+	StarType     sql.NullInt16
+	IsSecurity   sql.NullBool
+	IsBreaking   sql.NullBool
+	GroupID      sql.NullInt32
+	ListID       sql.NullInt32
+	Now          sql.NullTime
+}
+
+func (q *Queries) CountUnreadReleasesForUser(ctx context.Context, arg CountUnreadReleasesForUserParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadReleasesForUser,
+		arg.UserID,
+		arg.IsPrerelease,
+		arg.IsPrerelease,
+		arg.StarType,
+		arg.StarType,
+		arg.IsSecurity,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.IsBreaking,
+		arg.GroupID,
+		arg.GroupID,
+		arg.ListID,
+		arg.ListID,
+		arg.Now,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createApiToken = `-- name: CreateApiToken :execresult
+INSERT INTO
+  api_tokens (user_id, name, token_hash, token_prefix, scopes, created_at, expires_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateApiTokenParams struct {
+	UserID      int32
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scopes      string
+	CreatedAt   time.Time
+	ExpiresAt   sql.NullTime
+}
+
+func (q *Queries) CreateApiToken(ctx context.Context, arg CreateApiTokenParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createApiToken,
+		arg.UserID,
+		arg.Name,
+		arg.TokenHash,
+		arg.TokenPrefix,
+		arg.Scopes,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+}
+
 const createRepository = `-- name: CreateRepository :exec
 INSERT INTO
   repositories (
@@ -38,7 +219,7 @@ type CreateRepositoryParams struct {
 	Private      bool
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
-	LastSyncedAt time.Time
+	LastSyncedAt sql.NullTime
 	Hash         uint64
 }
 
@@ -58,6 +239,61 @@ func (q *Queries) CreateRepository(ctx context.Context, arg CreateRepositoryPara
 	return err
 }
 
+const createRepositoryGroup = `-- name: CreateRepositoryGroup :execresult
+INSERT INTO
+  repository_groups (user_id, name, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?)
+`
+
+type CreateRepositoryGroupParams struct {
+	UserID    int32
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) CreateRepositoryGroup(ctx context.Context, arg CreateRepositoryGroupParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createRepositoryGroup,
+		arg.UserID,
+		arg.Name,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+}
+
+const createSession = `-- name: CreateSession :exec
+INSERT INTO
+  sessions (id, user_id, refresh_token_id, user_agent, ip_address, created_at, last_used_at, expires_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateSessionParams struct {
+	ID             string
+	UserID         int32
+	RefreshTokenID string
+	UserAgent      string
+	IpAddress      string
+	CreatedAt      time.Time
+	LastUsedAt     time.Time
+	ExpiresAt      time.Time
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.ID,
+		arg.UserID,
+		arg.RefreshTokenID,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.CreatedAt,
+		arg.LastUsedAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :execresult
 INSERT INTO
   users (
@@ -67,20 +303,24 @@ INSERT INTO
     last_synced_at,
     is_public,
 	is_onboarded,
-    public_id
+    public_id,
+    github_scopes,
+    sync_private
   )
 VALUES
-  (?, ?, ?, ?, ?, ?, ?)
+  (?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateUserParams struct {
-	GithubID     uint64
+	GithubID     sql.NullInt64
 	Username     string
 	GithubToken  GitHubToken
 	LastSyncedAt time.Time
 	IsPublic     bool
 	IsOnboarded  bool
 	PublicID     string
+	GithubScopes string
+	SyncPrivate  bool
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (sql.Result, error) {
@@ -92,7 +332,143 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (sql.Res
 		arg.IsPublic,
 		arg.IsOnboarded,
 		arg.PublicID,
+		arg.GithubScopes,
+		arg.SyncPrivate,
+	)
+}
+
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO
+  user_identities (user_id, issuer, subject, email, created_at, last_login_at)
+VALUES
+  (?, ?, ?, ?, ?, ?)
+`
+
+type CreateUserIdentityParams struct {
+	UserID      int32
+	Issuer      string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) error {
+	_, err := q.db.ExecContext(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Issuer,
+		arg.Subject,
+		arg.Email,
+		arg.CreatedAt,
+		arg.LastLoginAt,
 	)
+	return err
+}
+
+const deleteApiToken = `-- name: DeleteApiToken :execresult
+DELETE FROM api_tokens
+WHERE
+  id = ?
+  AND user_id = ?
+`
+
+type DeleteApiTokenParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteApiToken(ctx context.Context, arg DeleteApiTokenParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteApiToken, arg.ID, arg.UserID)
+}
+
+const deleteBookmark = `-- name: DeleteBookmark :execresult
+DELETE FROM bookmarks
+WHERE
+  user_id = ?
+  AND release_id = ?
+`
+
+type DeleteBookmarkParams struct {
+	UserID    int32
+	ReleaseID int32
+}
+
+func (q *Queries) DeleteBookmark(ctx context.Context, arg DeleteBookmarkParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteBookmark, arg.UserID, arg.ReleaseID)
+}
+
+const deleteOrphanedRepository = `-- name: DeleteOrphanedRepository :execresult
+DELETE FROM repositories
+WHERE
+  id = ?
+  AND orphaned_at < ?
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+type DeleteOrphanedRepositoryParams struct {
+	ID             int32
+	OrphanedBefore sql.NullTime
+}
+
+func (q *Queries) DeleteOrphanedRepository(ctx context.Context, arg DeleteOrphanedRepositoryParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteOrphanedRepository, arg.ID, arg.OrphanedBefore)
+}
+
+const deletePrivateRepositoryStarsForUser = `-- name: DeletePrivateRepositoryStarsForUser :execresult
+DELETE FROM repository_stars
+WHERE
+  user_id = ?
+  AND repository_id IN (
+    SELECT
+      id
+    FROM
+      repositories
+    WHERE
+      private = true
+  )
+`
+
+func (q *Queries) DeletePrivateRepositoryStarsForUser(ctx context.Context, userID int32) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deletePrivateRepositoryStarsForUser, userID)
+}
+
+const deleteReleaseAssetsUpdatedBefore = `-- name: DeleteReleaseAssetsUpdatedBefore :exec
+DELETE FROM release_assets
+WHERE
+  updated_at < ?
+  AND release_id = ?
+`
+
+type DeleteReleaseAssetsUpdatedBeforeParams struct {
+	UpdatedAt time.Time
+	ReleaseID int32
+}
+
+func (q *Queries) DeleteReleaseAssetsUpdatedBefore(ctx context.Context, arg DeleteReleaseAssetsUpdatedBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteReleaseAssetsUpdatedBefore, arg.UpdatedAt, arg.ReleaseID)
+	return err
+}
+
+const deleteReleaseReadsCreatedBefore = `-- name: DeleteReleaseReadsCreatedBefore :exec
+DELETE FROM release_reads
+WHERE
+  created_at <= ?
+  AND user_id = ?
+`
+
+type DeleteReleaseReadsCreatedBeforeParams struct {
+	CreatedAt time.Time
+	UserID    int32
+}
+
+func (q *Queries) DeleteReleaseReadsCreatedBefore(ctx context.Context, arg DeleteReleaseReadsCreatedBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteReleaseReadsCreatedBefore, arg.CreatedAt, arg.UserID)
+	return err
 }
 
 const deleteReleasesOlderThan = `-- name: DeleteReleasesOlderThan :execresult
@@ -100,6 +476,7 @@ DELETE FROM releases
 WHERE
   released_at < ?
   AND repository_id = ?
+  AND id NOT IN (SELECT release_id FROM bookmarks)
 ORDER BY
   released_at DESC
 `
@@ -113,6 +490,38 @@ func (q *Queries) DeleteReleasesOlderThan(ctx context.Context, arg DeleteRelease
 	return q.db.ExecContext(ctx, deleteReleasesOlderThan, arg.ReleasedAt, arg.RepositoryID)
 }
 
+const deleteRepositoryGroup = `-- name: DeleteRepositoryGroup :execresult
+DELETE FROM repository_groups
+WHERE
+  id = ?
+  AND user_id = ?
+`
+
+type DeleteRepositoryGroupParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteRepositoryGroup(ctx context.Context, arg DeleteRepositoryGroupParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteRepositoryGroup, arg.ID, arg.UserID)
+}
+
+const deleteRepositoryGroupMember = `-- name: DeleteRepositoryGroupMember :execresult
+DELETE FROM repository_group_members
+WHERE
+  group_id = ?
+  AND repository_id = ?
+`
+
+type DeleteRepositoryGroupMemberParams struct {
+	GroupID      int32
+	RepositoryID int32
+}
+
+func (q *Queries) DeleteRepositoryGroupMember(ctx context.Context, arg DeleteRepositoryGroupMemberParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteRepositoryGroupMember, arg.GroupID, arg.RepositoryID)
+}
+
 const deleteRepositoryStarsUpdatedBefore = `-- name: DeleteRepositoryStarsUpdatedBefore :execresult
 DELETE FROM repository_stars
 WHERE
@@ -129,9 +538,90 @@ func (q *Queries) DeleteRepositoryStarsUpdatedBefore(ctx context.Context, arg De
 	return q.db.ExecContext(ctx, deleteRepositoryStarsUpdatedBefore, arg.UpdatedAt, arg.UserID)
 }
 
+const deleteSessionsEndedBefore = `-- name: DeleteSessionsEndedBefore :execresult
+DELETE FROM sessions
+WHERE
+  expires_at < ?
+  OR revoked_at < ?
+`
+
+func (q *Queries) DeleteSessionsEndedBefore(ctx context.Context, endedBefore time.Time) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteSessionsEndedBefore, endedBefore, endedBefore)
+}
+
+const deleteStarListRepositoriesUpdatedBefore = `-- name: DeleteStarListRepositoriesUpdatedBefore :exec
+DELETE FROM star_list_repositories
+WHERE
+  updated_at < ?
+  AND list_id = ?
+`
+
+type DeleteStarListRepositoriesUpdatedBeforeParams struct {
+	UpdatedAt time.Time
+	ListID    int32
+}
+
+func (q *Queries) DeleteStarListRepositoriesUpdatedBefore(ctx context.Context, arg DeleteStarListRepositoriesUpdatedBeforeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStarListRepositoriesUpdatedBefore, arg.UpdatedAt, arg.ListID)
+	return err
+}
+
+const deleteStarListsUpdatedBefore = `-- name: DeleteStarListsUpdatedBefore :execresult
+DELETE FROM star_lists
+WHERE
+  updated_at < ?
+  AND user_id = ?
+`
+
+type DeleteStarListsUpdatedBeforeParams struct {
+	UpdatedAt time.Time
+	UserID    int32
+}
+
+func (q *Queries) DeleteStarListsUpdatedBefore(ctx context.Context, arg DeleteStarListsUpdatedBeforeParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteStarListsUpdatedBefore, arg.UpdatedAt, arg.UserID)
+}
+
+const deleteUnfollowedRepositories = `-- name: DeleteUnfollowedRepositories :execresult
+DELETE FROM repositories
+WHERE
+  id IN (/*SLICE:repository_ids*/?)
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+func (q *Queries) DeleteUnfollowedRepositories(ctx context.Context, repositoryIds []int32) (sql.Result, error) {
+	query := deleteUnfollowedRepositories
+	var queryParams []interface{}
+	if len(repositoryIds) > 0 {
+		for _, v := range repositoryIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:repository_ids*/?", strings.Repeat(",?", len(repositoryIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:repository_ids*/?", "NULL", 1)
+	}
+	return q.db.ExecContext(ctx, query, queryParams...)
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users
+WHERE
+  id = ?
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, id)
+	return err
+}
+
 const findRepositoriesByUser = `-- name: FindRepositoriesByUser :many
 SELECT
-  id, github_id, name, url, private, repositories.created_at, repositories.updated_at, last_synced_at, image_url, image_size, hash, repository_id, user_id, repository_stars.created_at, repository_stars.updated_at, type
+  id, github_id, name, url, private, repositories.created_at, repositories.updated_at, last_synced_at, advisories_synced_at, image_url, image_size, hash, orphaned_at, next_sync_at, repository_id, user_id, repository_stars.created_at, repository_stars.updated_at, type, is_muted, snoozed_until, snoozed_until_major
 FROM
   repositories
 LEFT JOIN
@@ -141,22 +631,28 @@ WHERE
 `
 
 type FindRepositoriesByUserRow struct {
-	ID           int32
-	GithubID     string
-	Name         string
-	Url          string
-	Private      bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastSyncedAt time.Time
-	ImageUrl     string
-	ImageSize    int32
-	Hash         uint64
-	RepositoryID sql.NullInt32
-	UserID       sql.NullInt32
-	CreatedAt_2  sql.NullTime
-	UpdatedAt_2  sql.NullTime
-	Type         sql.NullInt16
+	ID                 int32
+	GithubID           string
+	Name               string
+	Url                string
+	Private            bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	LastSyncedAt       sql.NullTime
+	AdvisoriesSyncedAt sql.NullTime
+	ImageUrl           string
+	ImageSize          int32
+	Hash               uint64
+	OrphanedAt         sql.NullTime
+	NextSyncAt         sql.NullTime
+	RepositoryID       sql.NullInt32
+	UserID             sql.NullInt32
+	CreatedAt_2        sql.NullTime
+	UpdatedAt_2        sql.NullTime
+	Type               sql.NullInt16
+	IsMuted            sql.NullBool
+	SnoozedUntil       sql.NullTime
+	SnoozedUntilMajor  sql.NullInt32
 }
 
 func (q *Queries) FindRepositoriesByUser(ctx context.Context, userID int32) ([]FindRepositoriesByUserRow, error) {
@@ -177,14 +673,20 @@ func (q *Queries) FindRepositoriesByUser(ctx context.Context, userID int32) ([]F
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LastSyncedAt,
+			&i.AdvisoriesSyncedAt,
 			&i.ImageUrl,
 			&i.ImageSize,
 			&i.Hash,
+			&i.OrphanedAt,
+			&i.NextSyncAt,
 			&i.RepositoryID,
 			&i.UserID,
 			&i.CreatedAt_2,
 			&i.UpdatedAt_2,
 			&i.Type,
+			&i.IsMuted,
+			&i.SnoozedUntil,
+			&i.SnoozedUntilMajor,
 		); err != nil {
 			return nil, err
 		}
@@ -199,41 +701,80 @@ func (q *Queries) FindRepositoriesByUser(ctx context.Context, userID int32) ([]F
 	return items, nil
 }
 
-const getReleases = `-- name: GetReleases :many
+const getActiveSession = `-- name: GetActiveSession :one
 SELECT
-  github_id, id, repository_id, name, url, tag_name, description, description_short, author, is_prerelease, released_at, created_at, updated_at, hash
+  id, user_id, refresh_token_id, previous_refresh_token_id, rotated_at, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
 FROM
-  releases
+  sessions
 WHERE
-  repository_id = ?
-ORDER BY
-  released_at DESC
+  id = ?
+  AND revoked_at IS NULL
+  AND expires_at > ?
 `
 
-func (q *Queries) GetReleases(ctx context.Context, repositoryID int32) ([]Release, error) {
-	rows, err := q.db.QueryContext(ctx, getReleases, repositoryID)
+type GetActiveSessionParams struct {
+	ID  string
+	Now time.Time
+}
+
+func (q *Queries) GetActiveSession(ctx context.Context, arg GetActiveSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getActiveSession, arg.ID, arg.Now)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RefreshTokenID,
+		&i.PreviousRefreshTokenID,
+		&i.RotatedAt,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getActiveSessionsForUser = `-- name: GetActiveSessionsForUser :many
+SELECT
+  id, user_id, refresh_token_id, previous_refresh_token_id, rotated_at, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+FROM
+  sessions
+WHERE
+  user_id = ?
+  AND revoked_at IS NULL
+  AND expires_at > ?
+ORDER BY
+  last_used_at DESC
+`
+
+type GetActiveSessionsForUserParams struct {
+	UserID int32
+	Now    time.Time
+}
+
+func (q *Queries) GetActiveSessionsForUser(ctx context.Context, arg GetActiveSessionsForUserParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveSessionsForUser, arg.UserID, arg.Now)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Release
+	var items []Session
 	for rows.Next() {
-		var i Release
+		var i Session
 		if err := rows.Scan(
-			&i.GithubID,
 			&i.ID,
-			&i.RepositoryID,
-			&i.Name,
-			&i.Url,
-			&i.TagName,
-			&i.Description,
-			&i.DescriptionShort,
-			&i.Author,
-			&i.IsPrerelease,
-			&i.ReleasedAt,
+			&i.UserID,
+			&i.RefreshTokenID,
+			&i.PreviousRefreshTokenID,
+			&i.RotatedAt,
+			&i.UserAgent,
+			&i.IpAddress,
 			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Hash,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -248,103 +789,170 @@ func (q *Queries) GetReleases(ctx context.Context, repositoryID int32) ([]Releas
 	return items, nil
 }
 
-const getReleasesForUser = `-- name: GetReleasesForUser :many
+const getAdvisoriesForReleases = `-- name: GetAdvisoriesForReleases :many
 SELECT
-  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `github_id` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `description` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `created_at` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `updated_at` + "`" + `,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `image_size` + "`" + ` AS image_size,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `github_id` + "`" + ` AS repository_github_id,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url
+  release_id, ghsa_id, cve_id, severity, summary, url, published_at, created_at
 FROM
-  ` + "`" + `releases` + "`" + `
-  LEFT JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
-  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
-  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+  release_advisories
 WHERE
-  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
-  AND ` + "`" + `users` + "`" + `.` + "`" + `is_public` + "`" + ` = true
-  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
-  AND (? IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` = ?)
+  release_id IN (/*SLICE:release_ids*/?)
 ORDER BY
-  releases.released_at DESC
-LIMIT
-  100
+  published_at DESC
 `
 
-type GetReleasesForUserParams struct {
-	UserID       int32
-	IsPrerelease sql.NullBool
-	StarType     sql.NullInt16
+func (q *Queries) GetAdvisoriesForReleases(ctx context.Context, releaseIds []int32) ([]ReleaseAdvisory, error) {
+	query := getAdvisoriesForReleases
+	var queryParams []interface{}
+	if len(releaseIds) > 0 {
+		for _, v := range releaseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", strings.Repeat(",?", len(releaseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReleaseAdvisory
+	for rows.Next() {
+		var i ReleaseAdvisory
+		if err := rows.Scan(
+			&i.ReleaseID,
+			&i.GhsaID,
+			&i.CveID,
+			&i.Severity,
+			&i.Summary,
+			&i.Url,
+			&i.PublishedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type GetReleasesForUserRow struct {
-	ID                 int32
-	GithubID           string
-	RepositoryID       int32
-	Name               string
-	Url                string
-	TagName            string
-	Description        string
-	DescriptionShort   string
-	Author             sql.NullString
-	IsPrerelease       bool
-	ReleasedAt         time.Time
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	RepositoryName     sql.NullString
-	ImageUrl           sql.NullString
-	ImageSize          sql.NullInt32
-	RepositoryGithubID sql.NullString
-	RepositoryUrl      sql.NullString
-}
+const getApiTokenByHash = `-- name: GetApiTokenByHash :one
+SELECT
+  id, user_id, name, token_hash, token_prefix, scopes, created_at, last_used_at, expires_at
+FROM
+  api_tokens
+WHERE
+  token_hash = ?
+`
 
-func (q *Queries) GetReleasesForUser(ctx context.Context, arg GetReleasesForUserParams) ([]GetReleasesForUserRow, error) {
-	rows, err := q.db.QueryContext(ctx, getReleasesForUser,
-		arg.UserID,
-		arg.IsPrerelease,
-		arg.IsPrerelease,
-		arg.StarType,
-		arg.StarType,
+func (q *Queries) GetApiTokenByHash(ctx context.Context, tokenHash string) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, getApiTokenByHash, tokenHash)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.TokenPrefix,
+		&i.Scopes,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
 	)
+	return i, err
+}
+
+const getApiTokensForUser = `-- name: GetApiTokensForUser :many
+SELECT
+  id, user_id, name, token_hash, token_prefix, scopes, created_at, last_used_at, expires_at
+FROM
+  api_tokens
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC
+`
+
+func (q *Queries) GetApiTokensForUser(ctx context.Context, userID int32) ([]ApiToken, error) {
+	rows, err := q.db.QueryContext(ctx, getApiTokensForUser, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetReleasesForUserRow
+	var items []ApiToken
 	for rows.Next() {
-		var i GetReleasesForUserRow
+		var i ApiToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.TokenHash,
+			&i.TokenPrefix,
+			&i.Scopes,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAssetsForReleases = `-- name: GetAssetsForReleases :many
+SELECT
+  id, release_id, github_id, name, content_type, size, download_count, url, created_at, updated_at
+FROM
+  release_assets
+WHERE
+  release_id IN (/*SLICE:release_ids*/?)
+ORDER BY
+  name ASC
+`
+
+func (q *Queries) GetAssetsForReleases(ctx context.Context, releaseIds []int32) ([]ReleaseAsset, error) {
+	query := getAssetsForReleases
+	var queryParams []interface{}
+	if len(releaseIds) > 0 {
+		for _, v := range releaseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", strings.Repeat(",?", len(releaseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReleaseAsset
+	for rows.Next() {
+		var i ReleaseAsset
 		if err := rows.Scan(
 			&i.ID,
+			&i.ReleaseID,
 			&i.GithubID,
-			&i.RepositoryID,
 			&i.Name,
+			&i.ContentType,
+			&i.Size,
+			&i.DownloadCount,
 			&i.Url,
-			&i.TagName,
-			&i.Description,
-			&i.DescriptionShort,
-			&i.Author,
-			&i.IsPrerelease,
-			&i.ReleasedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.RepositoryName,
-			&i.ImageUrl,
-			&i.ImageSize,
-			&i.RepositoryGithubID,
-			&i.RepositoryUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -359,7 +967,7 @@ func (q *Queries) GetReleasesForUser(ctx context.Context, arg GetReleasesForUser
 	return items, nil
 }
 
-const getReleasesForUserShortDescription = `-- name: GetReleasesForUserShortDescription :many
+const getBookmarksForUser = `-- name: GetBookmarksForUser :many
 SELECT
   ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `github_id` + "`" + `,
@@ -367,72 +975,77 @@ SELECT
   ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_deprecation` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_migration_guide` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `vulnerability_ids` + "`" + `,
   ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `created_at` + "`" + `,
-  ` + "`" + `releases` + "`" + `.` + "`" + `updated_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + `,
   ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
   ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
-  ` + "`" + `repositories` + "`" + `.` + "`" + `image_size` + "`" + ` AS image_size,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `github_id` + "`" + ` AS repository_github_id,
   ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url,
-  ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` AS repository_star_type
+  ` + "`" + `bookmarks` + "`" + `.` + "`" + `note` + "`" + `,
+  ` + "`" + `bookmarks` + "`" + `.` + "`" + `created_at` + "`" + ` AS bookmarked_at,
+  ` + "`" + `bookmarks` + "`" + `.` + "`" + `updated_at` + "`" + ` AS bookmark_updated_at
 FROM
-  ` + "`" + `releases` + "`" + `
-  LEFT JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
-  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+  ` + "`" + `bookmarks` + "`" + `
+  INNER JOIN ` + "`" + `releases` + "`" + ` ON ` + "`" + `bookmarks` + "`" + `.` + "`" + `release_id` + "`" + ` = ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `
+  INNER JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+  LEFT JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + ` AND ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `bookmarks` + "`" + `.` + "`" + `user_id` + "`" + `
 WHERE
-  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
-  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
-  AND (? IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` = ?)
+  ` + "`" + `bookmarks` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND (
+    ` + "`" + `repositories` + "`" + `.` + "`" + `private` + "`" + ` = false
+    OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` IS NOT NULL
+  )
 ORDER BY
-  releases.released_at DESC
-LIMIT
-  100
+  ` + "`" + `bookmarks` + "`" + `.` + "`" + `created_at` + "`" + ` DESC
 `
 
-type GetReleasesForUserShortDescriptionParams struct {
-	UserID       int32
-	IsPrerelease sql.NullBool
-	StarType     sql.NullInt16
-}
-
-type GetReleasesForUserShortDescriptionRow struct {
+type GetBookmarksForUserRow struct {
 	ID                 int32
 	GithubID           string
 	RepositoryID       int32
 	Name               string
 	Url                string
 	TagName            string
+	Description        string
 	DescriptionShort   string
 	Author             sql.NullString
 	IsPrerelease       bool
+	IsSecurity         bool
+	IsBreaking         bool
+	HasDeprecation     bool
+	HasMigrationGuide  bool
+	VulnerabilityIds   string
 	ReleasedAt         time.Time
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	RepositoryName     sql.NullString
-	ImageUrl           sql.NullString
-	ImageSize          sql.NullInt32
-	RepositoryUrl      sql.NullString
-	RepositoryStarType int8
+	FirstSeenAt        sql.NullTime
+	IsBackfill         bool
+	RepositoryName     string
+	ImageUrl           string
+	RepositoryGithubID string
+	RepositoryUrl      string
+	Note               string
+	BookmarkedAt       time.Time
+	BookmarkUpdatedAt  time.Time
 }
 
-func (q *Queries) GetReleasesForUserShortDescription(ctx context.Context, arg GetReleasesForUserShortDescriptionParams) ([]GetReleasesForUserShortDescriptionRow, error) {
-	rows, err := q.db.QueryContext(ctx, getReleasesForUserShortDescription,
-		arg.UserID,
-		arg.IsPrerelease,
-		arg.IsPrerelease,
-		arg.StarType,
-		arg.StarType,
-	)
+func (q *Queries) GetBookmarksForUser(ctx context.Context, userID int32) ([]GetBookmarksForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBookmarksForUser, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetReleasesForUserShortDescriptionRow
+	var items []GetBookmarksForUserRow
 	for rows.Next() {
-		var i GetReleasesForUserShortDescriptionRow
+		var i GetBookmarksForUserRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.GithubID,
@@ -440,17 +1053,25 @@ func (q *Queries) GetReleasesForUserShortDescription(ctx context.Context, arg Ge
 			&i.Name,
 			&i.Url,
 			&i.TagName,
+			&i.Description,
 			&i.DescriptionShort,
 			&i.Author,
 			&i.IsPrerelease,
+			&i.IsSecurity,
+			&i.IsBreaking,
+			&i.HasDeprecation,
+			&i.HasMigrationGuide,
+			&i.VulnerabilityIds,
 			&i.ReleasedAt,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.FirstSeenAt,
+			&i.IsBackfill,
 			&i.RepositoryName,
 			&i.ImageUrl,
-			&i.ImageSize,
+			&i.RepositoryGithubID,
 			&i.RepositoryUrl,
-			&i.RepositoryStarType,
+			&i.Note,
+			&i.BookmarkedAt,
+			&i.BookmarkUpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -465,145 +1086,451 @@ func (q *Queries) GetReleasesForUserShortDescription(ctx context.Context, arg Ge
 	return items, nil
 }
 
-const getRepositoryByGithubID = `-- name: GetRepositoryByGithubID :one
+const getMutedRepositoriesForUser = `-- name: GetMutedRepositoriesForUser :many
 SELECT
-  id, github_id, name, url, private, created_at, updated_at, last_synced_at, image_url, image_size, hash
+  ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + `
 FROM
-  repositories
+  ` + "`" + `repository_stars` + "`" + `
+  INNER JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
 WHERE
-  github_id = ?
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND (
+    ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + ` = true
+    OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` IS NOT NULL
+    OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + ` IS NOT NULL
+  )
+ORDER BY
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` ASC
 `
 
-func (q *Queries) GetRepositoryByGithubID(ctx context.Context, githubID string) (Repository, error) {
-	row := q.db.QueryRowContext(ctx, getRepositoryByGithubID, githubID)
-	var i Repository
-	err := row.Scan(
-		&i.ID,
-		&i.GithubID,
-		&i.Name,
-		&i.Url,
-		&i.Private,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.LastSyncedAt,
-		&i.ImageUrl,
-		&i.ImageSize,
-		&i.Hash,
-	)
-	return i, err
+type GetMutedRepositoriesForUserRow struct {
+	ID                int32
+	Name              string
+	Url               string
+	ImageUrl          string
+	IsMuted           bool
+	SnoozedUntil      sql.NullTime
+	SnoozedUntilMajor sql.NullInt32
 }
 
-const getUserByGitHubID = `-- name: GetUserByGitHubID :one
-SELECT
-  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public
-FROM
-  users
+func (q *Queries) GetMutedRepositoriesForUser(ctx context.Context, userID int32) ([]GetMutedRepositoriesForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMutedRepositoriesForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMutedRepositoriesForUserRow
+	for rows.Next() {
+		var i GetMutedRepositoriesForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Url,
+			&i.ImageUrl,
+			&i.IsMuted,
+			&i.SnoozedUntil,
+			&i.SnoozedUntilMajor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNewerReleaseEdit = `-- name: GetNewerReleaseEdit :one
+SELECT
+  id, release_id, name, tag_name, description, is_prerelease, edited_at, diff
+FROM
+  release_edits
 WHERE
-  github_id = ?
+  release_id = ?
+  AND (
+    edited_at > ?
+    OR (
+      edited_at = ?
+      AND id > ?
+    )
+  )
+ORDER BY
+  edited_at ASC,
+  id ASC
+LIMIT
+  1
 `
 
-func (q *Queries) GetUserByGitHubID(ctx context.Context, githubID uint64) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByGitHubID, githubID)
-	var i User
+type GetNewerReleaseEditParams struct {
+	ReleaseID int32
+	EditedAt  time.Time
+	ID        int32
+}
+
+func (q *Queries) GetNewerReleaseEdit(ctx context.Context, arg GetNewerReleaseEditParams) (ReleaseEdit, error) {
+	row := q.db.QueryRowContext(ctx, getNewerReleaseEdit,
+		arg.ReleaseID,
+		arg.EditedAt,
+		arg.EditedAt,
+		arg.ID,
+	)
+	var i ReleaseEdit
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
+		&i.ReleaseID,
+		&i.Name,
+		&i.TagName,
+		&i.Description,
+		&i.IsPrerelease,
+		&i.EditedAt,
+		&i.Diff,
+	)
+	return i, err
+}
+
+const getNextRelease = `-- name: GetNextRelease :one
+SELECT
+  github_id, id, repository_id, name, url, tag_name, description, description_short, description_text, author, is_prerelease, is_security, is_breaking, has_deprecation, has_migration_guide, vulnerability_ids, signals_detected, major_version, released_at, github_created_at, first_seen_at, is_backfill, edited_at, retracted_at, created_at, updated_at, hash
+FROM
+  releases
+WHERE
+  repository_id = ?
+  AND retracted_at IS NULL
+  AND (
+    released_at > ?
+    OR (released_at = ? AND id > ?)
+  )
+ORDER BY
+  released_at ASC,
+  id ASC
+LIMIT
+  1
+`
+
+type GetNextReleaseParams struct {
+	RepositoryID int32
+	ReleasedAt   time.Time
+	ID           int32
+}
+
+func (q *Queries) GetNextRelease(ctx context.Context, arg GetNextReleaseParams) (Release, error) {
+	row := q.db.QueryRowContext(ctx, getNextRelease,
+		arg.RepositoryID,
+		arg.ReleasedAt,
+		arg.ReleasedAt,
+		arg.ID,
+	)
+	var i Release
+	err := row.Scan(
 		&i.GithubID,
-		&i.GithubToken,
-		&i.LastSyncedAt,
-		&i.PublicID,
-		&i.IsOnboarded,
-		&i.IsPublic,
+		&i.ID,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Url,
+		&i.TagName,
+		&i.Description,
+		&i.DescriptionShort,
+		&i.DescriptionText,
+		&i.Author,
+		&i.IsPrerelease,
+		&i.IsSecurity,
+		&i.IsBreaking,
+		&i.HasDeprecation,
+		&i.HasMigrationGuide,
+		&i.VulnerabilityIds,
+		&i.SignalsDetected,
+		&i.MajorVersion,
+		&i.ReleasedAt,
+		&i.GithubCreatedAt,
+		&i.FirstSeenAt,
+		&i.IsBackfill,
+		&i.EditedAt,
+		&i.RetractedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Hash,
 	)
 	return i, err
 }
 
-const getUserByID = `-- name: GetUserByID :one
+const getPreviousRelease = `-- name: GetPreviousRelease :one
 SELECT
-  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public
+  github_id, id, repository_id, name, url, tag_name, description, description_short, description_text, author, is_prerelease, is_security, is_breaking, has_deprecation, has_migration_guide, vulnerability_ids, signals_detected, major_version, released_at, github_created_at, first_seen_at, is_backfill, edited_at, retracted_at, created_at, updated_at, hash
 FROM
-  users
+  releases
 WHERE
-  id = ?
+  repository_id = ?
+  AND retracted_at IS NULL
+  AND (
+    released_at < ?
+    OR (released_at = ? AND id < ?)
+  )
+ORDER BY
+  released_at DESC,
+  id DESC
+LIMIT
+  1
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
-	var i User
+type GetPreviousReleaseParams struct {
+	RepositoryID int32
+	ReleasedAt   time.Time
+	ID           int32
+}
+
+func (q *Queries) GetPreviousRelease(ctx context.Context, arg GetPreviousReleaseParams) (Release, error) {
+	row := q.db.QueryRowContext(ctx, getPreviousRelease,
+		arg.RepositoryID,
+		arg.ReleasedAt,
+		arg.ReleasedAt,
+		arg.ID,
+	)
+	var i Release
 	err := row.Scan(
+		&i.GithubID,
 		&i.ID,
-		&i.Username,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Url,
+		&i.TagName,
+		&i.Description,
+		&i.DescriptionShort,
+		&i.DescriptionText,
+		&i.Author,
+		&i.IsPrerelease,
+		&i.IsSecurity,
+		&i.IsBreaking,
+		&i.HasDeprecation,
+		&i.HasMigrationGuide,
+		&i.VulnerabilityIds,
+		&i.SignalsDetected,
+		&i.MajorVersion,
+		&i.ReleasedAt,
+		&i.GithubCreatedAt,
+		&i.FirstSeenAt,
+		&i.IsBackfill,
+		&i.EditedAt,
+		&i.RetractedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Hash,
+	)
+	return i, err
+}
+
+const getReleaseAssets = `-- name: GetReleaseAssets :many
+SELECT
+  id, release_id, github_id, name, content_type, size, download_count, url, created_at, updated_at
+FROM
+  release_assets
+WHERE
+  release_id = ?
+ORDER BY
+  name ASC
+`
+
+func (q *Queries) GetReleaseAssets(ctx context.Context, releaseID int32) ([]ReleaseAsset, error) {
+	rows, err := q.db.QueryContext(ctx, getReleaseAssets, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReleaseAsset
+	for rows.Next() {
+		var i ReleaseAsset
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseID,
+			&i.GithubID,
+			&i.Name,
+			&i.ContentType,
+			&i.Size,
+			&i.DownloadCount,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleaseByID = `-- name: GetReleaseByID :one
+SELECT
+  github_id, id, repository_id, name, url, tag_name, description, description_short, description_text, author, is_prerelease, is_security, is_breaking, has_deprecation, has_migration_guide, vulnerability_ids, signals_detected, major_version, released_at, github_created_at, first_seen_at, is_backfill, edited_at, retracted_at, created_at, updated_at, hash
+FROM
+  releases
+WHERE
+  id = ?
+`
+
+func (q *Queries) GetReleaseByID(ctx context.Context, id int32) (Release, error) {
+	row := q.db.QueryRowContext(ctx, getReleaseByID, id)
+	var i Release
+	err := row.Scan(
 		&i.GithubID,
-		&i.GithubToken,
-		&i.LastSyncedAt,
-		&i.PublicID,
-		&i.IsOnboarded,
-		&i.IsPublic,
+		&i.ID,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Url,
+		&i.TagName,
+		&i.Description,
+		&i.DescriptionShort,
+		&i.DescriptionText,
+		&i.Author,
+		&i.IsPrerelease,
+		&i.IsSecurity,
+		&i.IsBreaking,
+		&i.HasDeprecation,
+		&i.HasMigrationGuide,
+		&i.VulnerabilityIds,
+		&i.SignalsDetected,
+		&i.MajorVersion,
+		&i.ReleasedAt,
+		&i.GithubCreatedAt,
+		&i.FirstSeenAt,
+		&i.IsBackfill,
+		&i.EditedAt,
+		&i.RetractedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Hash,
 	)
 	return i, err
 }
 
-const getUserByPublicID = `-- name: GetUserByPublicID :one
+const getReleaseEdit = `-- name: GetReleaseEdit :one
 SELECT
-  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public
+  id, release_id, name, tag_name, description, is_prerelease, edited_at, diff
 FROM
-  users
+  release_edits
 WHERE
-  public_id = ?
+  id = ?
+  AND release_id = ?
 `
 
-func (q *Queries) GetUserByPublicID(ctx context.Context, publicID string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByPublicID, publicID)
-	var i User
+type GetReleaseEditParams struct {
+	ID        int32
+	ReleaseID int32
+}
+
+func (q *Queries) GetReleaseEdit(ctx context.Context, arg GetReleaseEditParams) (ReleaseEdit, error) {
+	row := q.db.QueryRowContext(ctx, getReleaseEdit, arg.ID, arg.ReleaseID)
+	var i ReleaseEdit
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.GithubID,
-		&i.GithubToken,
-		&i.LastSyncedAt,
-		&i.PublicID,
-		&i.IsOnboarded,
-		&i.IsPublic,
+		&i.ReleaseID,
+		&i.Name,
+		&i.TagName,
+		&i.Description,
+		&i.IsPrerelease,
+		&i.EditedAt,
+		&i.Diff,
 	)
 	return i, err
 }
 
-const getUsersInNeedOfAnUpdate = `-- name: GetUsersInNeedOfAnUpdate :many
+const getReleaseEdits = `-- name: GetReleaseEdits :many
 SELECT
-  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public
+  id, release_id, name, tag_name, description, is_prerelease, edited_at, diff
 FROM
-  users
+  release_edits
 WHERE
-  last_synced_at < ?
+  release_id = ?
 ORDER BY
-  last_synced_at DESC
-LIMIT
-  ?
+  edited_at DESC
 `
 
-type GetUsersInNeedOfAnUpdateParams struct {
-	LastSyncedAt time.Time
-	Limit        int32
+func (q *Queries) GetReleaseEdits(ctx context.Context, releaseID int32) ([]ReleaseEdit, error) {
+	rows, err := q.db.QueryContext(ctx, getReleaseEdits, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReleaseEdit
+	for rows.Next() {
+		var i ReleaseEdit
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseID,
+			&i.Name,
+			&i.TagName,
+			&i.Description,
+			&i.IsPrerelease,
+			&i.EditedAt,
+			&i.Diff,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetUsersInNeedOfAnUpdate(ctx context.Context, arg GetUsersInNeedOfAnUpdateParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, getUsersInNeedOfAnUpdate, arg.LastSyncedAt, arg.Limit)
+const getReleaseEditsForReleases = `-- name: GetReleaseEditsForReleases :many
+SELECT
+  id, release_id, name, tag_name, description, is_prerelease, edited_at, diff
+FROM
+  release_edits
+WHERE
+  release_id IN (/*SLICE:release_ids*/?)
+ORDER BY
+  edited_at DESC,
+  id DESC
+`
+
+func (q *Queries) GetReleaseEditsForReleases(ctx context.Context, releaseIds []int32) ([]ReleaseEdit, error) {
+	query := getReleaseEditsForReleases
+	var queryParams []interface{}
+	if len(releaseIds) > 0 {
+		for _, v := range releaseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", strings.Repeat(",?", len(releaseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:release_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []User
+	var items []ReleaseEdit
 	for rows.Next() {
-		var i User
+		var i ReleaseEdit
 		if err := rows.Scan(
 			&i.ID,
-			&i.Username,
-			&i.GithubID,
-			&i.GithubToken,
-			&i.LastSyncedAt,
-			&i.PublicID,
-			&i.IsOnboarded,
-			&i.IsPublic,
+			&i.ReleaseID,
+			&i.Name,
+			&i.TagName,
+			&i.Description,
+			&i.IsPrerelease,
+			&i.EditedAt,
+			&i.Diff,
 		); err != nil {
 			return nil, err
 		}
@@ -618,99 +1545,2360 @@ func (q *Queries) GetUsersInNeedOfAnUpdate(ctx context.Context, arg GetUsersInNe
 	return items, nil
 }
 
-const insertRelease = `-- name: InsertRelease :exec
-INSERT INTO
-  releases (
-    github_id,
-    repository_id,
-    name,
-    author,
-    tag_name,
-    url,
-    description,
-    description_short,
-    hash,
-    released_at,
-    created_at,
-    updated_at,
-    is_prerelease
-  )
-VALUES
-  (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+const getReleaseFetchDepthsForRepository = `-- name: GetReleaseFetchDepthsForRepository :many
+SELECT
+  ` + "`" + `users` + "`" + `.` + "`" + `release_fetch_depth` + "`" + `
+FROM
+  ` + "`" + `repository_stars` + "`" + `
+  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + ` = ?
 `
 
-type InsertReleaseParams struct {
-	GithubID         string
-	RepositoryID     int32
-	Name             string
-	Author           sql.NullString
-	TagName          string
-	Url              string
-	Description      string
-	DescriptionShort string
-	Hash             uint64
-	ReleasedAt       time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	IsPrerelease     bool
-}
-
-func (q *Queries) InsertRelease(ctx context.Context, arg InsertReleaseParams) error {
-	_, err := q.db.ExecContext(ctx, insertRelease,
-		arg.GithubID,
-		arg.RepositoryID,
-		arg.Name,
-		arg.Author,
-		arg.TagName,
+func (q *Queries) GetReleaseFetchDepthsForRepository(ctx context.Context, repositoryID int32) ([]sql.NullInt32, error) {
+	rows, err := q.db.QueryContext(ctx, getReleaseFetchDepthsForRepository, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullInt32
+	for rows.Next() {
+		var releaseFetchDepth sql.NullInt32
+		if err := rows.Scan(&releaseFetchDepth); err != nil {
+			return nil, err
+		}
+		items = append(items, releaseFetchDepth)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleaseForUser = `-- name: GetReleaseForUser :one
+SELECT
+  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `github_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_deprecation` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_migration_guide` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `vulnerability_ids` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `edited_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `retracted_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` AS repository_star_type
+FROM
+  ` + "`" + `releases` + "`" + `
+  INNER JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+WHERE
+  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + ` = ?
+  AND ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+`
+
+type GetReleaseForUserParams struct {
+	ID     int32
+	UserID int32
+}
+
+type GetReleaseForUserRow struct {
+	ID                 int32
+	GithubID           string
+	RepositoryID       int32
+	Name               string
+	Url                string
+	TagName            string
+	Description        string
+	DescriptionShort   string
+	Author             sql.NullString
+	IsPrerelease       bool
+	IsSecurity         bool
+	IsBreaking         bool
+	HasDeprecation     bool
+	HasMigrationGuide  bool
+	VulnerabilityIds   string
+	ReleasedAt         time.Time
+	EditedAt           sql.NullTime
+	RetractedAt        sql.NullTime
+	FirstSeenAt        sql.NullTime
+	IsBackfill         bool
+	RepositoryName     string
+	ImageUrl           string
+	RepositoryUrl      string
+	RepositoryStarType int8
+}
+
+func (q *Queries) GetReleaseForUser(ctx context.Context, arg GetReleaseForUserParams) (GetReleaseForUserRow, error) {
+	row := q.db.QueryRowContext(ctx, getReleaseForUser, arg.ID, arg.UserID)
+	var i GetReleaseForUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.GithubID,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Url,
+		&i.TagName,
+		&i.Description,
+		&i.DescriptionShort,
+		&i.Author,
+		&i.IsPrerelease,
+		&i.IsSecurity,
+		&i.IsBreaking,
+		&i.HasDeprecation,
+		&i.HasMigrationGuide,
+		&i.VulnerabilityIds,
+		&i.ReleasedAt,
+		&i.EditedAt,
+		&i.RetractedAt,
+		&i.FirstSeenAt,
+		&i.IsBackfill,
+		&i.RepositoryName,
+		&i.ImageUrl,
+		&i.RepositoryUrl,
+		&i.RepositoryStarType,
+	)
+	return i, err
+}
+
+const getReleaseReadsForUser = `-- name: GetReleaseReadsForUser :many
+SELECT
+  ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `release_reads` + "`" + `.` + "`" + `created_at` + "`" + `
+FROM
+  ` + "`" + `release_reads` + "`" + `
+  INNER JOIN ` + "`" + `releases` + "`" + ` ON ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + ` = ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `release_reads` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+ORDER BY
+  ` + "`" + `release_reads` + "`" + `.` + "`" + `created_at` + "`" + ` DESC
+`
+
+type GetReleaseReadsForUserRow struct {
+	ReleaseID    int32
+	RepositoryID int32
+	Name         string
+	Url          string
+	CreatedAt    time.Time
+}
+
+func (q *Queries) GetReleaseReadsForUser(ctx context.Context, userID int32) ([]GetReleaseReadsForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReleaseReadsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReleaseReadsForUserRow
+	for rows.Next() {
+		var i GetReleaseReadsForUserRow
+		if err := rows.Scan(
+			&i.ReleaseID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Url,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleaseRetentionsForRepository = `-- name: GetReleaseRetentionsForRepository :many
+SELECT
+  ` + "`" + `users` + "`" + `.` + "`" + `release_retention` + "`" + `
+FROM
+  ` + "`" + `repository_stars` + "`" + `
+  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + ` = ?
+`
+
+func (q *Queries) GetReleaseRetentionsForRepository(ctx context.Context, repositoryID int32) ([]sql.NullInt32, error) {
+	rows, err := q.db.QueryContext(ctx, getReleaseRetentionsForRepository, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullInt32
+	for rows.Next() {
+		var releaseRetention sql.NullInt32
+		if err := rows.Scan(&releaseRetention); err != nil {
+			return nil, err
+		}
+		items = append(items, releaseRetention)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleases = `-- name: GetReleases :many
+SELECT
+  github_id, id, repository_id, name, url, tag_name, description, description_short, description_text, author, is_prerelease, is_security, is_breaking, has_deprecation, has_migration_guide, vulnerability_ids, signals_detected, major_version, released_at, github_created_at, first_seen_at, is_backfill, edited_at, retracted_at, created_at, updated_at, hash
+FROM
+  releases
+WHERE
+  repository_id = ?
+ORDER BY
+  released_at DESC
+`
+
+func (q *Queries) GetReleases(ctx context.Context, repositoryID int32) ([]Release, error) {
+	rows, err := q.db.QueryContext(ctx, getReleases, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Release
+	for rows.Next() {
+		var i Release
+		if err := rows.Scan(
+			&i.GithubID,
+			&i.ID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Url,
+			&i.TagName,
+			&i.Description,
+			&i.DescriptionShort,
+			&i.DescriptionText,
+			&i.Author,
+			&i.IsPrerelease,
+			&i.IsSecurity,
+			&i.IsBreaking,
+			&i.HasDeprecation,
+			&i.HasMigrationGuide,
+			&i.VulnerabilityIds,
+			&i.SignalsDetected,
+			&i.MajorVersion,
+			&i.ReleasedAt,
+			&i.GithubCreatedAt,
+			&i.FirstSeenAt,
+			&i.IsBackfill,
+			&i.EditedAt,
+			&i.RetractedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleasesForUser = `-- name: GetReleasesForUser :many
+SELECT
+  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `github_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_deprecation` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_migration_guide` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `vulnerability_ids` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `edited_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `retracted_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `created_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `updated_at` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_size` + "`" + ` AS image_size,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `github_id` + "`" + ` AS repository_github_id,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url
+FROM
+  ` + "`" + `releases` + "`" + `
+  LEFT JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND ` + "`" + `users` + "`" + `.` + "`" + `is_public` + "`" + ` = true
+  AND ` + "`" + `repositories` + "`" + `.` + "`" + `private` + "`" + ` = false
+  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + ` = ?)
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `repository_group_members` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `repository_group_members` + "`" + `
+      WHERE ` + "`" + `repository_group_members` + "`" + `.` + "`" + `group_id` + "`" + ` = ?
+    )
+  )
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `star_list_repositories` + "`" + `
+      WHERE ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `list_id` + "`" + ` = ?
+    )
+  )
+  AND ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + ` = false
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` <= ?)
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` > ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + `)
+ORDER BY
+  releases.first_seen_at DESC,
+  releases.released_at DESC
+LIMIT
+  100
+`
+
+type GetReleasesForUserParams struct {
+	UserID       int32
+	IsPrerelease sql.NullBool
+	StarType     sql.NullInt16
+	IsSecurity   sql.NullBool
+	IsBreaking   sql.NullBool
+	GroupID      sql.NullInt32
+	ListID       sql.NullInt32
+	Now          sql.NullTime
+}
+
+type GetReleasesForUserRow struct {
+	ID                 int32
+	GithubID           string
+	RepositoryID       int32
+	Name               string
+	Url                string
+	TagName            string
+	Description        string
+	DescriptionShort   string
+	Author             sql.NullString
+	IsPrerelease       bool
+	IsSecurity         bool
+	IsBreaking         bool
+	HasDeprecation     bool
+	HasMigrationGuide  bool
+	VulnerabilityIds   string
+	ReleasedAt         time.Time
+	EditedAt           sql.NullTime
+	RetractedAt        sql.NullTime
+	FirstSeenAt        sql.NullTime
+	IsBackfill         bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	RepositoryName     sql.NullString
+	ImageUrl           sql.NullString
+	ImageSize          sql.NullInt32
+	RepositoryGithubID sql.NullString
+	RepositoryUrl      sql.NullString
+}
+
+func (q *Queries) GetReleasesForUser(ctx context.Context, arg GetReleasesForUserParams) ([]GetReleasesForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReleasesForUser,
+		arg.UserID,
+		arg.IsPrerelease,
+		arg.IsPrerelease,
+		arg.StarType,
+		arg.StarType,
+		arg.IsSecurity,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.IsBreaking,
+		arg.GroupID,
+		arg.GroupID,
+		arg.ListID,
+		arg.ListID,
+		arg.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReleasesForUserRow
+	for rows.Next() {
+		var i GetReleasesForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GithubID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Url,
+			&i.TagName,
+			&i.Description,
+			&i.DescriptionShort,
+			&i.Author,
+			&i.IsPrerelease,
+			&i.IsSecurity,
+			&i.IsBreaking,
+			&i.HasDeprecation,
+			&i.HasMigrationGuide,
+			&i.VulnerabilityIds,
+			&i.ReleasedAt,
+			&i.EditedAt,
+			&i.RetractedAt,
+			&i.FirstSeenAt,
+			&i.IsBackfill,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepositoryName,
+			&i.ImageUrl,
+			&i.ImageSize,
+			&i.RepositoryGithubID,
+			&i.RepositoryUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleasesForUserShortDescription = `-- name: GetReleasesForUserShortDescription :many
+SELECT
+  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `github_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_deprecation` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_migration_guide` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `vulnerability_ids` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `edited_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `retracted_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `created_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `updated_at` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_size` + "`" + ` AS image_size,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` AS repository_star_type,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `created_at` + "`" + ` AS starred_at,
+  ` + "`" + `release_reads` + "`" + `.` + "`" + `created_at` + "`" + ` AS read_at
+FROM
+  ` + "`" + `releases` + "`" + `
+  LEFT JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+  INNER JOIN ` + "`" + `users` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+  LEFT JOIN ` + "`" + `release_reads` + "`" + ` ON ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + ` = ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + ` AND ` + "`" + `release_reads` + "`" + `.` + "`" + `user_id` + "`" + ` = ` + "`" + `users` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + ` = ?)
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `repository_group_members` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `repository_group_members` + "`" + `
+      WHERE ` + "`" + `repository_group_members` + "`" + `.` + "`" + `group_id` + "`" + ` = ?
+    )
+  )
+  AND (
+    ? IS NULL
+    OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` IN (
+      SELECT ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `repository_id` + "`" + `
+      FROM ` + "`" + `star_list_repositories` + "`" + `
+      WHERE ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `list_id` + "`" + ` = ?
+    )
+  )
+  AND ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + ` = false
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` IS NULL OR ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + ` <= ?)
+  AND (` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `major_version` + "`" + ` > ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + `)
+  AND (
+    ? = false
+    OR (
+      ` + "`" + `release_reads` + "`" + `.` + "`" + `release_id` + "`" + ` IS NULL
+      AND ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + ` = false
+      AND ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` >= ` + "`" + `repository_stars` + "`" + `.` + "`" + `created_at` + "`" + `
+      AND (` + "`" + `users` + "`" + `.` + "`" + `read_all_at` + "`" + ` IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` > ` + "`" + `users` + "`" + `.` + "`" + `read_all_at` + "`" + `)
+    )
+  )
+  AND (
+    ? IS NULL
+    OR (
+      ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` > ?
+      AND ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + ` = false
+      AND ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + ` >= ` + "`" + `repository_stars` + "`" + `.` + "`" + `created_at` + "`" + `
+    )
+  )
+ORDER BY
+  releases.released_at DESC
+LIMIT
+  100
+`
+
+type GetReleasesForUserShortDescriptionParams struct {
+	UserID       int32
+	IsPrerelease sql.NullBool
+	StarType     sql.NullInt16
+	IsSecurity   sql.NullBool
+	IsBreaking   sql.NullBool
+	GroupID      sql.NullInt32
+	ListID       sql.NullInt32
+	Now          sql.NullTime
+	UnreadOnly   interface{}
+	NewSince     sql.NullTime
+}
+
+type GetReleasesForUserShortDescriptionRow struct {
+	ID                 int32
+	GithubID           string
+	RepositoryID       int32
+	Name               string
+	Url                string
+	TagName            string
+	DescriptionShort   string
+	Author             sql.NullString
+	IsPrerelease       bool
+	IsSecurity         bool
+	IsBreaking         bool
+	HasDeprecation     bool
+	HasMigrationGuide  bool
+	VulnerabilityIds   string
+	ReleasedAt         time.Time
+	EditedAt           sql.NullTime
+	RetractedAt        sql.NullTime
+	FirstSeenAt        sql.NullTime
+	IsBackfill         bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	RepositoryName     sql.NullString
+	ImageUrl           sql.NullString
+	ImageSize          sql.NullInt32
+	RepositoryUrl      sql.NullString
+	RepositoryStarType int8
+	StarredAt          time.Time
+	ReadAt             sql.NullTime
+}
+
+func (q *Queries) GetReleasesForUserShortDescription(ctx context.Context, arg GetReleasesForUserShortDescriptionParams) ([]GetReleasesForUserShortDescriptionRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReleasesForUserShortDescription,
+		arg.UserID,
+		arg.IsPrerelease,
+		arg.IsPrerelease,
+		arg.StarType,
+		arg.StarType,
+		arg.IsSecurity,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.IsBreaking,
+		arg.GroupID,
+		arg.GroupID,
+		arg.ListID,
+		arg.ListID,
+		arg.Now,
+		arg.UnreadOnly,
+		arg.NewSince,
+		arg.NewSince,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReleasesForUserShortDescriptionRow
+	for rows.Next() {
+		var i GetReleasesForUserShortDescriptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GithubID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Url,
+			&i.TagName,
+			&i.DescriptionShort,
+			&i.Author,
+			&i.IsPrerelease,
+			&i.IsSecurity,
+			&i.IsBreaking,
+			&i.HasDeprecation,
+			&i.HasMigrationGuide,
+			&i.VulnerabilityIds,
+			&i.ReleasedAt,
+			&i.EditedAt,
+			&i.RetractedAt,
+			&i.FirstSeenAt,
+			&i.IsBackfill,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepositoryName,
+			&i.ImageUrl,
+			&i.ImageSize,
+			&i.RepositoryUrl,
+			&i.RepositoryStarType,
+			&i.StarredAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReleasesWithoutSignals = `-- name: GetReleasesWithoutSignals :many
+SELECT
+  id,
+  description
+FROM
+  releases
+WHERE
+  signals_detected = false
+  AND id > ?
+ORDER BY
+  id ASC
+LIMIT
+  ?
+`
+
+type GetReleasesWithoutSignalsParams struct {
+	AfterID int32
+	Limit   int32
+}
+
+type GetReleasesWithoutSignalsRow struct {
+	ID          int32
+	Description string
+}
+
+func (q *Queries) GetReleasesWithoutSignals(ctx context.Context, arg GetReleasesWithoutSignalsParams) ([]GetReleasesWithoutSignalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReleasesWithoutSignals, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReleasesWithoutSignalsRow
+	for rows.Next() {
+		var i GetReleasesWithoutSignalsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoriesDueForSync = `-- name: GetRepositoriesDueForSync :many
+SELECT
+  id, github_id, name, url, private, created_at, updated_at, last_synced_at, advisories_synced_at, image_url, image_size, hash, orphaned_at, next_sync_at
+FROM
+  repositories
+WHERE
+  (
+    next_sync_at IS NULL
+    OR next_sync_at <= ?
+  )
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      repository_stars
+  )
+ORDER BY
+  next_sync_at ASC
+LIMIT
+  ?
+`
+
+type GetRepositoriesDueForSyncParams struct {
+	Now   sql.NullTime
+	Limit int32
+}
+
+func (q *Queries) GetRepositoriesDueForSync(ctx context.Context, arg GetRepositoriesDueForSyncParams) ([]Repository, error) {
+	rows, err := q.db.QueryContext(ctx, getRepositoriesDueForSync, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Repository
+	for rows.Next() {
+		var i Repository
+		if err := rows.Scan(
+			&i.ID,
+			&i.GithubID,
+			&i.Name,
+			&i.Url,
+			&i.Private,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastSyncedAt,
+			&i.AdvisoriesSyncedAt,
+			&i.ImageUrl,
+			&i.ImageSize,
+			&i.Hash,
+			&i.OrphanedAt,
+			&i.NextSyncAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoriesDueForSyncForUser = `-- name: GetRepositoriesDueForSyncForUser :many
+SELECT
+  id, github_id, name, url, private, created_at, updated_at, last_synced_at, advisories_synced_at, image_url, image_size, hash, orphaned_at, next_sync_at
+FROM
+  repositories
+WHERE
+  (
+    next_sync_at IS NULL
+    OR next_sync_at <= ?
+  )
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      repository_stars
+    WHERE
+      user_id = ?
+  )
+`
+
+type GetRepositoriesDueForSyncForUserParams struct {
+	Now    sql.NullTime
+	UserID int32
+}
+
+func (q *Queries) GetRepositoriesDueForSyncForUser(ctx context.Context, arg GetRepositoriesDueForSyncForUserParams) ([]Repository, error) {
+	rows, err := q.db.QueryContext(ctx, getRepositoriesDueForSyncForUser, arg.Now, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Repository
+	for rows.Next() {
+		var i Repository
+		if err := rows.Scan(
+			&i.ID,
+			&i.GithubID,
+			&i.Name,
+			&i.Url,
+			&i.Private,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastSyncedAt,
+			&i.AdvisoriesSyncedAt,
+			&i.ImageUrl,
+			&i.ImageSize,
+			&i.Hash,
+			&i.OrphanedAt,
+			&i.NextSyncAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoriesOrphanedBefore = `-- name: GetRepositoriesOrphanedBefore :many
+SELECT
+  ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + `,
+  COUNT(` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `) AS release_count
+FROM
+  ` + "`" + `repositories` + "`" + `
+  LEFT JOIN ` + "`" + `releases` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repositories` + "`" + `.` + "`" + `orphaned_at` + "`" + ` < ?
+  AND ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + ` > ?
+  AND ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + ` NOT IN (
+    SELECT
+      ` + "`" + `repository_id` + "`" + `
+    FROM
+      ` + "`" + `followed_repositories` + "`" + `
+  )
+GROUP BY
+  ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + `
+ORDER BY
+  ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + ` ASC
+LIMIT
+  ?
+`
+
+type GetRepositoriesOrphanedBeforeParams struct {
+	OrphanedBefore sql.NullTime
+	AfterID        int32
+	Limit          int32
+}
+
+type GetRepositoriesOrphanedBeforeRow struct {
+	ID           int32
+	Name         string
+	ReleaseCount int64
+}
+
+func (q *Queries) GetRepositoriesOrphanedBefore(ctx context.Context, arg GetRepositoriesOrphanedBeforeParams) ([]GetRepositoriesOrphanedBeforeRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRepositoriesOrphanedBefore, arg.OrphanedBefore, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRepositoriesOrphanedBeforeRow
+	for rows.Next() {
+		var i GetRepositoriesOrphanedBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ReleaseCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoryByGithubID = `-- name: GetRepositoryByGithubID :one
+SELECT
+  id, github_id, name, url, private, created_at, updated_at, last_synced_at, advisories_synced_at, image_url, image_size, hash, orphaned_at, next_sync_at
+FROM
+  repositories
+WHERE
+  github_id = ?
+`
+
+func (q *Queries) GetRepositoryByGithubID(ctx context.Context, githubID string) (Repository, error) {
+	row := q.db.QueryRowContext(ctx, getRepositoryByGithubID, githubID)
+	var i Repository
+	err := row.Scan(
+		&i.ID,
+		&i.GithubID,
+		&i.Name,
+		&i.Url,
+		&i.Private,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSyncedAt,
+		&i.AdvisoriesSyncedAt,
+		&i.ImageUrl,
+		&i.ImageSize,
+		&i.Hash,
+		&i.OrphanedAt,
+		&i.NextSyncAt,
+	)
+	return i, err
+}
+
+const getRepositoryGroup = `-- name: GetRepositoryGroup :one
+SELECT
+  id, user_id, name, created_at, updated_at
+FROM
+  repository_groups
+WHERE
+  id = ?
+  AND user_id = ?
+`
+
+type GetRepositoryGroupParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) GetRepositoryGroup(ctx context.Context, arg GetRepositoryGroupParams) (RepositoryGroup, error) {
+	row := q.db.QueryRowContext(ctx, getRepositoryGroup, arg.ID, arg.UserID)
+	var i RepositoryGroup
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRepositoryGroupByName = `-- name: GetRepositoryGroupByName :one
+SELECT
+  id, user_id, name, created_at, updated_at
+FROM
+  repository_groups
+WHERE
+  user_id = ?
+  AND name = ?
+`
+
+type GetRepositoryGroupByNameParams struct {
+	UserID int32
+	Name   string
+}
+
+func (q *Queries) GetRepositoryGroupByName(ctx context.Context, arg GetRepositoryGroupByNameParams) (RepositoryGroup, error) {
+	row := q.db.QueryRowContext(ctx, getRepositoryGroupByName, arg.UserID, arg.Name)
+	var i RepositoryGroup
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRepositoryGroupMembersForUser = `-- name: GetRepositoryGroupMembersForUser :many
+SELECT
+  ` + "`" + `repository_group_members` + "`" + `.` + "`" + `group_id` + "`" + `,
+  ` + "`" + `repository_group_members` + "`" + `.` + "`" + `repository_id` + "`" + `
+FROM
+  ` + "`" + `repository_group_members` + "`" + `
+  INNER JOIN ` + "`" + `repository_groups` + "`" + ` ON ` + "`" + `repository_group_members` + "`" + `.` + "`" + `group_id` + "`" + ` = ` + "`" + `repository_groups` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_groups` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+`
+
+type GetRepositoryGroupMembersForUserRow struct {
+	GroupID      int32
+	RepositoryID int32
+}
+
+func (q *Queries) GetRepositoryGroupMembersForUser(ctx context.Context, userID int32) ([]GetRepositoryGroupMembersForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRepositoryGroupMembersForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRepositoryGroupMembersForUserRow
+	for rows.Next() {
+		var i GetRepositoryGroupMembersForUserRow
+		if err := rows.Scan(
+			&i.GroupID,
+			&i.RepositoryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoryGroupsForUser = `-- name: GetRepositoryGroupsForUser :many
+SELECT
+  id, user_id, name, created_at, updated_at
+FROM
+  repository_groups
+WHERE
+  user_id = ?
+ORDER BY
+  name ASC
+`
+
+func (q *Queries) GetRepositoryGroupsForUser(ctx context.Context, userID int32) ([]RepositoryGroup, error) {
+	rows, err := q.db.QueryContext(ctx, getRepositoryGroupsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepositoryGroup
+	for rows.Next() {
+		var i RepositoryGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoryIDsByGithubIDs = `-- name: GetRepositoryIDsByGithubIDs :many
+SELECT
+  id,
+  github_id
+FROM
+  repositories
+WHERE
+  github_id IN (/*SLICE:github_ids*/?)
+`
+
+type GetRepositoryIDsByGithubIDsRow struct {
+	ID       int32
+	GithubID string
+}
+
+func (q *Queries) GetRepositoryIDsByGithubIDs(ctx context.Context, githubIds []string) ([]GetRepositoryIDsByGithubIDsRow, error) {
+	query := getRepositoryIDsByGithubIDs
+	var queryParams []interface{}
+	if len(githubIds) > 0 {
+		for _, v := range githubIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:github_ids*/?", strings.Repeat(",?", len(githubIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:github_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRepositoryIDsByGithubIDsRow
+	for rows.Next() {
+		var i GetRepositoryIDsByGithubIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GithubID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepositoryStar = `-- name: GetRepositoryStar :one
+SELECT
+  repository_id, user_id, created_at, updated_at, type, is_muted, snoozed_until, snoozed_until_major
+FROM
+  repository_stars
+WHERE
+  repository_id = ?
+  AND user_id = ?
+`
+
+type GetRepositoryStarParams struct {
+	RepositoryID int32
+	UserID       int32
+}
+
+func (q *Queries) GetRepositoryStar(ctx context.Context, arg GetRepositoryStarParams) (RepositoryStar, error) {
+	row := q.db.QueryRowContext(ctx, getRepositoryStar, arg.RepositoryID, arg.UserID)
+	var i RepositoryStar
+	err := row.Scan(
+		&i.RepositoryID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Type,
+		&i.IsMuted,
+		&i.SnoozedUntil,
+		&i.SnoozedUntilMajor,
+	)
+	return i, err
+}
+
+const getRepositorySyncUser = `-- name: GetRepositorySyncUser :one
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  github_token IS NOT NULL
+  AND id IN (
+    SELECT
+      user_id
+    FROM
+      repository_stars
+    WHERE
+      repository_id = ?
+  )
+ORDER BY
+  RAND()
+LIMIT
+  1
+`
+
+func (q *Queries) GetRepositorySyncUser(ctx context.Context, repositoryID int32) (User, error) {
+	row := q.db.QueryRowContext(ctx, getRepositorySyncUser, repositoryID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.GithubID,
+		&i.GithubToken,
+		&i.LastSyncedAt,
+		&i.PublicID,
+		&i.IsOnboarded,
+		&i.IsPublic,
+		&i.ReadAllAt,
+		&i.PrivateFeedID,
+		&i.ReleaseFetchDepth,
+		&i.ReleaseRetention,
+		&i.GithubScopes,
+		&i.SyncPrivate,
+	)
+	return i, err
+}
+
+const getSessionsForUser = `-- name: GetSessionsForUser :many
+SELECT
+  id, user_id, refresh_token_id, previous_refresh_token_id, rotated_at, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+FROM
+  sessions
+WHERE
+  user_id = ?
+ORDER BY
+  created_at DESC
+`
+
+func (q *Queries) GetSessionsForUser(ctx context.Context, userID int32) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RefreshTokenID,
+			&i.PreviousRefreshTokenID,
+			&i.RotatedAt,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStarList = `-- name: GetStarList :one
+SELECT
+  id, user_id, github_id, name, slug, created_at, updated_at
+FROM
+  star_lists
+WHERE
+  id = ?
+  AND user_id = ?
+`
+
+type GetStarListParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) GetStarList(ctx context.Context, arg GetStarListParams) (StarList, error) {
+	row := q.db.QueryRowContext(ctx, getStarList, arg.ID, arg.UserID)
+	var i StarList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GithubID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getStarListByGithubID = `-- name: GetStarListByGithubID :one
+SELECT
+  id, user_id, github_id, name, slug, created_at, updated_at
+FROM
+  star_lists
+WHERE
+  github_id = ?
+  AND user_id = ?
+`
+
+type GetStarListByGithubIDParams struct {
+	GithubID string
+	UserID   int32
+}
+
+func (q *Queries) GetStarListByGithubID(ctx context.Context, arg GetStarListByGithubIDParams) (StarList, error) {
+	row := q.db.QueryRowContext(ctx, getStarListByGithubID, arg.GithubID, arg.UserID)
+	var i StarList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GithubID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getStarListBySlug = `-- name: GetStarListBySlug :one
+SELECT
+  id, user_id, github_id, name, slug, created_at, updated_at
+FROM
+  star_lists
+WHERE
+  user_id = ?
+  AND slug = ?
+`
+
+type GetStarListBySlugParams struct {
+	UserID int32
+	Slug   string
+}
+
+func (q *Queries) GetStarListBySlug(ctx context.Context, arg GetStarListBySlugParams) (StarList, error) {
+	row := q.db.QueryRowContext(ctx, getStarListBySlug, arg.UserID, arg.Slug)
+	var i StarList
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GithubID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getStarListRepositoriesForUser = `-- name: GetStarListRepositoriesForUser :many
+SELECT
+  ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `list_id` + "`" + `,
+  ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `repository_id` + "`" + `
+FROM
+  ` + "`" + `star_list_repositories` + "`" + `
+  INNER JOIN ` + "`" + `star_lists` + "`" + ` ON ` + "`" + `star_list_repositories` + "`" + `.` + "`" + `list_id` + "`" + ` = ` + "`" + `star_lists` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `star_lists` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+`
+
+type GetStarListRepositoriesForUserRow struct {
+	ListID       int32
+	RepositoryID int32
+}
+
+func (q *Queries) GetStarListRepositoriesForUser(ctx context.Context, userID int32) ([]GetStarListRepositoriesForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getStarListRepositoriesForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStarListRepositoriesForUserRow
+	for rows.Next() {
+		var i GetStarListRepositoriesForUserRow
+		if err := rows.Scan(
+			&i.ListID,
+			&i.RepositoryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStarListsForUser = `-- name: GetStarListsForUser :many
+SELECT
+  id, user_id, github_id, name, slug, created_at, updated_at
+FROM
+  star_lists
+WHERE
+  user_id = ?
+ORDER BY
+  name ASC
+`
+
+func (q *Queries) GetStarListsForUser(ctx context.Context, userID int32) ([]StarList, error) {
+	rows, err := q.db.QueryContext(ctx, getStarListsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StarList
+	for rows.Next() {
+		var i StarList
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GithubID,
+			&i.Name,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStarsForUser = `-- name: GetStarsForUser :many
+SELECT
+  ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `is_muted` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `snoozed_until_major` + "`" + `,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `created_at` + "`" + `
+FROM
+  ` + "`" + `repository_stars` + "`" + `
+  INNER JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+ORDER BY
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` ASC
+`
+
+type GetStarsForUserRow struct {
+	ID                int32
+	Name              string
+	Url               string
+	Type              int8
+	IsMuted           bool
+	SnoozedUntil      sql.NullTime
+	SnoozedUntilMajor sql.NullInt32
+	CreatedAt         time.Time
+}
+
+func (q *Queries) GetStarsForUser(ctx context.Context, userID int32) ([]GetStarsForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getStarsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStarsForUserRow
+	for rows.Next() {
+		var i GetStarsForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Url,
+			&i.Type,
+			&i.IsMuted,
+			&i.SnoozedUntil,
+			&i.SnoozedUntilMajor,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByGitHubID = `-- name: GetUserByGitHubID :one
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  github_id = ?
+`
+
+func (q *Queries) GetUserByGitHubID(ctx context.Context, githubID sql.NullInt64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByGitHubID, githubID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.GithubID,
+		&i.GithubToken,
+		&i.LastSyncedAt,
+		&i.PublicID,
+		&i.IsOnboarded,
+		&i.IsPublic,
+		&i.ReadAllAt,
+		&i.PrivateFeedID,
+		&i.ReleaseFetchDepth,
+		&i.ReleaseRetention,
+		&i.GithubScopes,
+		&i.SyncPrivate,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  id = ?
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.GithubID,
+		&i.GithubToken,
+		&i.LastSyncedAt,
+		&i.PublicID,
+		&i.IsOnboarded,
+		&i.IsPublic,
+		&i.ReadAllAt,
+		&i.PrivateFeedID,
+		&i.ReleaseFetchDepth,
+		&i.ReleaseRetention,
+		&i.GithubScopes,
+		&i.SyncPrivate,
+	)
+	return i, err
+}
+
+const getUserByPrivateFeedID = `-- name: GetUserByPrivateFeedID :one
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  private_feed_id = ?
+`
+
+func (q *Queries) GetUserByPrivateFeedID(ctx context.Context, privateFeedID sql.NullString) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByPrivateFeedID, privateFeedID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.GithubID,
+		&i.GithubToken,
+		&i.LastSyncedAt,
+		&i.PublicID,
+		&i.IsOnboarded,
+		&i.IsPublic,
+		&i.ReadAllAt,
+		&i.PrivateFeedID,
+		&i.ReleaseFetchDepth,
+		&i.ReleaseRetention,
+		&i.GithubScopes,
+		&i.SyncPrivate,
+	)
+	return i, err
+}
+
+const getUserByPublicID = `-- name: GetUserByPublicID :one
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  public_id = ?
+`
+
+func (q *Queries) GetUserByPublicID(ctx context.Context, publicID string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByPublicID, publicID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.GithubID,
+		&i.GithubToken,
+		&i.LastSyncedAt,
+		&i.PublicID,
+		&i.IsOnboarded,
+		&i.IsPublic,
+		&i.ReadAllAt,
+		&i.PrivateFeedID,
+		&i.ReleaseFetchDepth,
+		&i.ReleaseRetention,
+		&i.GithubScopes,
+		&i.SyncPrivate,
+	)
+	return i, err
+}
+
+const getUserIdentitiesForUser = `-- name: GetUserIdentitiesForUser :many
+SELECT
+  id, user_id, issuer, subject, email, created_at, last_login_at
+FROM
+  user_identities
+WHERE
+  user_id = ?
+`
+
+func (q *Queries) GetUserIdentitiesForUser(ctx context.Context, userID int32) ([]UserIdentity, error) {
+	rows, err := q.db.QueryContext(ctx, getUserIdentitiesForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserIdentity
+	for rows.Next() {
+		var i UserIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Issuer,
+			&i.Subject,
+			&i.Email,
+			&i.CreatedAt,
+			&i.LastLoginAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserIdentity = `-- name: GetUserIdentity :one
+SELECT
+  id, user_id, issuer, subject, email, created_at, last_login_at
+FROM
+  user_identities
+WHERE
+  issuer = ?
+  AND subject = ?
+`
+
+type GetUserIdentityParams struct {
+	Issuer  string
+	Subject string
+}
+
+func (q *Queries) GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRowContext(ctx, getUserIdentity, arg.Issuer, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Issuer,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const getUsersInNeedOfAnUpdate = `-- name: GetUsersInNeedOfAnUpdate :many
+SELECT
+  id, username, github_id, github_token, last_synced_at, public_id, is_onboarded, is_public, read_all_at, private_feed_id, release_fetch_depth, release_retention, github_scopes, sync_private
+FROM
+  users
+WHERE
+  github_id IS NOT NULL
+  AND last_synced_at < ?
+ORDER BY
+  last_synced_at DESC
+LIMIT
+  ?
+`
+
+type GetUsersInNeedOfAnUpdateParams struct {
+	LastSyncedAt time.Time
+	Limit        int32
+}
+
+func (q *Queries) GetUsersInNeedOfAnUpdate(ctx context.Context, arg GetUsersInNeedOfAnUpdateParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, getUsersInNeedOfAnUpdate, arg.LastSyncedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.GithubID,
+			&i.GithubToken,
+			&i.LastSyncedAt,
+			&i.PublicID,
+			&i.IsOnboarded,
+			&i.IsPublic,
+			&i.ReadAllAt,
+			&i.PrivateFeedID,
+			&i.ReleaseFetchDepth,
+			&i.ReleaseRetention,
+			&i.GithubScopes,
+			&i.SyncPrivate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersWithTokenKeyOtherThan = `-- name: GetUsersWithTokenKeyOtherThan :many
+SELECT
+  id,
+  username
+FROM
+  users
+WHERE
+  github_token IS NOT NULL
+  AND COALESCE(JSON_UNQUOTE(JSON_EXTRACT(github_token, '$.key_id')), '') != ?
+  AND id > ?
+ORDER BY
+  id ASC
+LIMIT
+  ?
+`
+
+type GetUsersWithTokenKeyOtherThanParams struct {
+	KeyID   interface{}
+	AfterID int32
+	Limit   int32
+}
+
+type GetUsersWithTokenKeyOtherThanRow struct {
+	ID       int32
+	Username string
+}
+
+func (q *Queries) GetUsersWithTokenKeyOtherThan(ctx context.Context, arg GetUsersWithTokenKeyOtherThanParams) ([]GetUsersWithTokenKeyOtherThanRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUsersWithTokenKeyOtherThan, arg.KeyID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsersWithTokenKeyOtherThanRow
+	for rows.Next() {
+		var i GetUsersWithTokenKeyOtherThanRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertRelease = `-- name: InsertRelease :execresult
+INSERT INTO
+  releases (
+    github_id,
+    repository_id,
+    name,
+    author,
+    tag_name,
+    url,
+    description,
+    description_short,
+    description_text,
+    hash,
+    is_security,
+    is_breaking,
+    has_deprecation,
+    has_migration_guide,
+    vulnerability_ids,
+    signals_detected,
+    major_version,
+    released_at,
+    github_created_at,
+    first_seen_at,
+    is_backfill,
+    created_at,
+    updated_at,
+    is_prerelease
+  )
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertReleaseParams struct {
+	GithubID          string
+	RepositoryID      int32
+	Name              string
+	Author            sql.NullString
+	TagName           string
+	Url               string
+	Description       string
+	DescriptionShort  string
+	DescriptionText   string
+	Hash              uint64
+	IsSecurity        bool
+	IsBreaking        bool
+	HasDeprecation    bool
+	HasMigrationGuide bool
+	VulnerabilityIds  string
+	SignalsDetected   bool
+	MajorVersion      sql.NullInt32
+	ReleasedAt        time.Time
+	GithubCreatedAt   sql.NullTime
+	FirstSeenAt       sql.NullTime
+	IsBackfill        bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	IsPrerelease      bool
+}
+
+func (q *Queries) InsertRelease(ctx context.Context, arg InsertReleaseParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, insertRelease,
+		arg.GithubID,
+		arg.RepositoryID,
+		arg.Name,
+		arg.Author,
+		arg.TagName,
 		arg.Url,
 		arg.Description,
 		arg.DescriptionShort,
+		arg.DescriptionText,
 		arg.Hash,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.HasDeprecation,
+		arg.HasMigrationGuide,
+		arg.VulnerabilityIds,
+		arg.SignalsDetected,
+		arg.MajorVersion,
 		arg.ReleasedAt,
+		arg.GithubCreatedAt,
+		arg.FirstSeenAt,
+		arg.IsBackfill,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.IsPrerelease,
 	)
+}
+
+const insertReleaseEdit = `-- name: InsertReleaseEdit :exec
+INSERT INTO
+  release_edits (release_id, name, tag_name, description, is_prerelease, edited_at, diff)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertReleaseEditParams struct {
+	ReleaseID    int32
+	Name         string
+	TagName      string
+	Description  string
+	IsPrerelease bool
+	EditedAt     time.Time
+	Diff         json.RawMessage
+}
+
+func (q *Queries) InsertReleaseEdit(ctx context.Context, arg InsertReleaseEditParams) error {
+	_, err := q.db.ExecContext(ctx, insertReleaseEdit,
+		arg.ReleaseID,
+		arg.Name,
+		arg.TagName,
+		arg.Description,
+		arg.IsPrerelease,
+		arg.EditedAt,
+		arg.Diff,
+	)
+	return err
+}
+
+const insertReleaseRead = `-- name: InsertReleaseRead :exec
+INSERT IGNORE INTO
+  release_reads (user_id, release_id, created_at)
+VALUES
+  (?, ?, ?)
+`
+
+type InsertReleaseReadParams struct {
+	UserID    int32
+	ReleaseID int32
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertReleaseRead(ctx context.Context, arg InsertReleaseReadParams) error {
+	_, err := q.db.ExecContext(ctx, insertReleaseRead, arg.UserID, arg.ReleaseID, arg.CreatedAt)
+	return err
+}
+
+const insertRepositoryGroupMember = `-- name: InsertRepositoryGroupMember :exec
+INSERT IGNORE INTO
+  repository_group_members (group_id, repository_id, created_at)
+VALUES
+  (?, ?, ?)
+`
+
+type InsertRepositoryGroupMemberParams struct {
+	GroupID      int32
+	RepositoryID int32
+	CreatedAt    time.Time
+}
+
+func (q *Queries) InsertRepositoryGroupMember(ctx context.Context, arg InsertRepositoryGroupMemberParams) error {
+	_, err := q.db.ExecContext(ctx, insertRepositoryGroupMember, arg.GroupID, arg.RepositoryID, arg.CreatedAt)
+	return err
+}
+
+const insertRepositoryReleaseReads = `-- name: InsertRepositoryReleaseReads :exec
+INSERT IGNORE INTO
+  release_reads (user_id, release_id, created_at)
+SELECT
+  repository_stars.user_id,
+  releases.id,
+  ?
+FROM
+  releases
+  INNER JOIN repository_stars ON repository_stars.repository_id = releases.repository_id
+WHERE
+  releases.repository_id = ?
+  AND repository_stars.user_id = ?
+`
+
+type InsertRepositoryReleaseReadsParams struct {
+	CreatedAt    interface{}
+	RepositoryID int32
+	UserID       int32
+}
+
+func (q *Queries) InsertRepositoryReleaseReads(ctx context.Context, arg InsertRepositoryReleaseReadsParams) error {
+	_, err := q.db.ExecContext(ctx, insertRepositoryReleaseReads, arg.CreatedAt, arg.RepositoryID, arg.UserID)
+	return err
+}
+
+const insertRepositoryStar = `-- name: InsertRepositoryStar :exec
+INSERT INTO
+  repository_stars (repository_id, user_id, type, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?)
+`
+
+type InsertRepositoryStarParams struct {
+	RepositoryID int32
+	UserID       int32
+	Type         int8
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (q *Queries) InsertRepositoryStar(ctx context.Context, arg InsertRepositoryStarParams) error {
+	_, err := q.db.ExecContext(ctx, insertRepositoryStar,
+		arg.RepositoryID,
+		arg.UserID,
+		arg.Type,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const insertStarList = `-- name: InsertStarList :execresult
+INSERT INTO
+  star_lists (user_id, github_id, name, slug, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?, ?)
+`
+
+type InsertStarListParams struct {
+	UserID    int32
+	GithubID  string
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) InsertStarList(ctx context.Context, arg InsertStarListParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, insertStarList,
+		arg.UserID,
+		arg.GithubID,
+		arg.Name,
+		arg.Slug,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+}
+
+const linkUserGithub = `-- name: LinkUserGithub :execresult
+UPDATE users
+SET
+  github_id = ?
+WHERE
+  id = ?
+  AND github_id IS NULL
+`
+
+type LinkUserGithubParams struct {
+	GithubID sql.NullInt64
+	ID       int32
+}
+
+func (q *Queries) LinkUserGithub(ctx context.Context, arg LinkUserGithubParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, linkUserGithub, arg.GithubID, arg.ID)
+}
+
+const markOrphanedRepositories = `-- name: MarkOrphanedRepositories :execresult
+UPDATE repositories
+SET
+  orphaned_at = ?
+WHERE
+  orphaned_at IS NULL
+  AND id NOT IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+func (q *Queries) MarkOrphanedRepositories(ctx context.Context, orphanedAt sql.NullTime) (sql.Result, error) {
+	return q.db.ExecContext(ctx, markOrphanedRepositories, orphanedAt)
+}
+
+const markReleaseSecurity = `-- name: MarkReleaseSecurity :exec
+UPDATE releases
+SET
+  is_security = true
+WHERE
+  id = ?
+`
+
+func (q *Queries) MarkReleaseSecurity(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, markReleaseSecurity, id)
+	return err
+}
+
+const retractRelease = `-- name: RetractRelease :exec
+UPDATE releases
+SET
+  retracted_at = ?
+WHERE
+  id = ?
+  AND retracted_at IS NULL
+`
+
+type RetractReleaseParams struct {
+	RetractedAt sql.NullTime
+	ID          int32
+}
+
+func (q *Queries) RetractRelease(ctx context.Context, arg RetractReleaseParams) error {
+	_, err := q.db.ExecContext(ctx, retractRelease, arg.RetractedAt, arg.ID)
 	return err
 }
 
-const insertRepositoryStar = `-- name: InsertRepositoryStar :exec
-INSERT INTO
-  repository_stars (repository_id, user_id, type, created_at, updated_at)
-VALUES
-  (?, ?, ?, ?, ?)
+const revokeSession = `-- name: RevokeSession :execresult
+UPDATE sessions
+SET
+  revoked_at = ?
+WHERE
+  id = ?
+  AND user_id = ?
+  AND revoked_at IS NULL
+`
+
+type RevokeSessionParams struct {
+	RevokedAt sql.NullTime
+	ID        string
+	UserID    int32
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, revokeSession, arg.RevokedAt, arg.ID, arg.UserID)
+}
+
+const revokeSessionsForUser = `-- name: RevokeSessionsForUser :execresult
+UPDATE sessions
+SET
+  revoked_at = ?
+WHERE
+  user_id = ?
+  AND id != ?
+  AND revoked_at IS NULL
+`
+
+type RevokeSessionsForUserParams struct {
+	RevokedAt sql.NullTime
+	UserID    int32
+	ExceptID  string
+}
+
+func (q *Queries) RevokeSessionsForUser(ctx context.Context, arg RevokeSessionsForUserParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, revokeSessionsForUser, arg.RevokedAt, arg.UserID, arg.ExceptID)
+}
+
+const rotateSessionRefreshToken = `-- name: RotateSessionRefreshToken :execresult
+UPDATE sessions
+SET
+  previous_refresh_token_id = refresh_token_id,
+  refresh_token_id = ?,
+  rotated_at = ?,
+  user_agent = ?,
+  ip_address = ?,
+  last_used_at = ?,
+  expires_at = ?
+WHERE
+  id = ?
+  AND refresh_token_id = ?
+  AND revoked_at IS NULL
+`
+
+type RotateSessionRefreshTokenParams struct {
+	RefreshTokenID        string
+	RotatedAt             sql.NullTime
+	UserAgent             string
+	IpAddress             string
+	ExpiresAt             time.Time
+	ID                    string
+	CurrentRefreshTokenID string
+}
+
+func (q *Queries) RotateSessionRefreshToken(ctx context.Context, arg RotateSessionRefreshTokenParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, rotateSessionRefreshToken,
+		arg.RefreshTokenID,
+		arg.RotatedAt,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.RotatedAt,
+		arg.ExpiresAt,
+		arg.ID,
+		arg.CurrentRefreshTokenID,
+	)
+}
+
+const searchReleasesForUser = `-- name: SearchReleasesForUser :many
+SELECT
+  ` + "`" + `releases` + "`" + `.` + "`" + `id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `url` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description_short` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `description_text` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `author` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_prerelease` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_security` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_breaking` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_deprecation` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `has_migration_guide` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `vulnerability_ids` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `first_seen_at` + "`" + `,
+  ` + "`" + `releases` + "`" + `.` + "`" + `is_backfill` + "`" + `,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `name` + "`" + ` AS repository_name,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `image_url` + "`" + ` AS image_url,
+  ` + "`" + `repositories` + "`" + `.` + "`" + `url` + "`" + ` AS repository_url,
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `type` + "`" + ` AS repository_star_type
+FROM
+  ` + "`" + `releases` + "`" + `
+  INNER JOIN ` + "`" + `repositories` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repositories` + "`" + `.` + "`" + `id` + "`" + `
+  INNER JOIN ` + "`" + `repository_stars` + "`" + ` ON ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ` + "`" + `repository_stars` + "`" + `.` + "`" + `repository_id` + "`" + `
+WHERE
+  ` + "`" + `repository_stars` + "`" + `.` + "`" + `user_id` + "`" + ` = ?
+  AND MATCH (` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `, ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `, ` + "`" + `releases` + "`" + `.` + "`" + `description_text` + "`" + `) AGAINST (? IN BOOLEAN MODE)
+  AND (? IS NULL OR ` + "`" + `is_prerelease` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `repository_id` + "`" + ` = ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + ` >= ?)
+  AND (? IS NULL OR ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + ` <= ?)
+ORDER BY
+  MATCH (` + "`" + `releases` + "`" + `.` + "`" + `name` + "`" + `, ` + "`" + `releases` + "`" + `.` + "`" + `tag_name` + "`" + `, ` + "`" + `releases` + "`" + `.` + "`" + `description_text` + "`" + `) AGAINST (? IN BOOLEAN MODE) DESC,
+  ` + "`" + `releases` + "`" + `.` + "`" + `released_at` + "`" + ` DESC
+LIMIT
+  50
+`
+
+type SearchReleasesForUserParams struct {
+	UserID         int32
+	Query          interface{}
+	IsPrerelease   sql.NullBool
+	RepositoryID   sql.NullInt32
+	ReleasedAfter  sql.NullTime
+	ReleasedBefore sql.NullTime
+}
+
+type SearchReleasesForUserRow struct {
+	ID                 int32
+	RepositoryID       int32
+	Name               string
+	Url                string
+	TagName            string
+	DescriptionShort   string
+	DescriptionText    string
+	Author             sql.NullString
+	IsPrerelease       bool
+	IsSecurity         bool
+	IsBreaking         bool
+	HasDeprecation     bool
+	HasMigrationGuide  bool
+	VulnerabilityIds   string
+	ReleasedAt         time.Time
+	FirstSeenAt        sql.NullTime
+	IsBackfill         bool
+	RepositoryName     string
+	ImageUrl           string
+	RepositoryUrl      string
+	RepositoryStarType int8
+}
+
+func (q *Queries) SearchReleasesForUser(ctx context.Context, arg SearchReleasesForUserParams) ([]SearchReleasesForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchReleasesForUser,
+		arg.UserID,
+		arg.Query,
+		arg.IsPrerelease,
+		arg.IsPrerelease,
+		arg.RepositoryID,
+		arg.RepositoryID,
+		arg.ReleasedAfter,
+		arg.ReleasedAfter,
+		arg.ReleasedBefore,
+		arg.ReleasedBefore,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchReleasesForUserRow
+	for rows.Next() {
+		var i SearchReleasesForUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Url,
+			&i.TagName,
+			&i.DescriptionShort,
+			&i.DescriptionText,
+			&i.Author,
+			&i.IsPrerelease,
+			&i.IsSecurity,
+			&i.IsBreaking,
+			&i.HasDeprecation,
+			&i.HasMigrationGuide,
+			&i.VulnerabilityIds,
+			&i.ReleasedAt,
+			&i.FirstSeenAt,
+			&i.IsBackfill,
+			&i.RepositoryName,
+			&i.ImageUrl,
+			&i.RepositoryUrl,
+			&i.RepositoryStarType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchApiToken = `-- name: TouchApiToken :exec
+UPDATE api_tokens
+SET
+  last_used_at = ?
+WHERE
+  id = ?
+  AND (
+    last_used_at IS NULL
+    OR last_used_at < ?
+  )
 `
 
-type InsertRepositoryStarParams struct {
-	RepositoryID int32
-	UserID       int32
-	Type         int8
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+type TouchApiTokenParams struct {
+	LastUsedAt    sql.NullTime
+	ID            int32
+	TouchedBefore sql.NullTime
 }
 
-func (q *Queries) InsertRepositoryStar(ctx context.Context, arg InsertRepositoryStarParams) error {
-	_, err := q.db.ExecContext(ctx, insertRepositoryStar,
-		arg.RepositoryID,
-		arg.UserID,
-		arg.Type,
-		arg.CreatedAt,
-		arg.UpdatedAt,
-	)
+func (q *Queries) TouchApiToken(ctx context.Context, arg TouchApiTokenParams) error {
+	_, err := q.db.ExecContext(ctx, touchApiToken, arg.LastUsedAt, arg.ID, arg.TouchedBefore)
 	return err
 }
 
+const unmarkFollowedRepositories = `-- name: UnmarkFollowedRepositories :execresult
+UPDATE repositories
+SET
+  orphaned_at = NULL
+WHERE
+  orphaned_at IS NOT NULL
+  AND id IN (
+    SELECT
+      repository_id
+    FROM
+      followed_repositories
+  )
+`
+
+func (q *Queries) UnmarkFollowedRepositories(ctx context.Context) (sql.Result, error) {
+	return q.db.ExecContext(ctx, unmarkFollowedRepositories)
+}
+
 const updateRelease = `-- name: UpdateRelease :execresult
 UPDATE releases
 SET
   github_id = ?,
+  tag_name = ?,
   name = ?,
   url = ?,
   description = ?,
   description_short = ?,
+  description_text = ?,
   author = ?,
   is_prerelease = ?,
+  is_security = ? OR EXISTS (
+    SELECT 1 FROM release_advisories WHERE release_advisories.release_id = releases.id
+  ),
+  is_breaking = ?,
+  has_deprecation = ?,
+  has_migration_guide = ?,
+  vulnerability_ids = ?,
+  signals_detected = true,
+  major_version = ?,
   released_at = ?,
+  github_created_at = ?,
+  edited_at = ?,
+  retracted_at = NULL,
   updated_at = ?,
   hash = ?
 WHERE
@@ -718,35 +3906,91 @@ WHERE
 `
 
 type UpdateReleaseParams struct {
-	GithubID         string
-	Name             string
-	Url              string
-	Description      string
-	DescriptionShort string
-	Author           sql.NullString
-	IsPrerelease     bool
-	ReleasedAt       time.Time
-	UpdatedAt        time.Time
-	Hash             uint64
-	ID               int32
+	GithubID          string
+	TagName           string
+	Name              string
+	Url               string
+	Description       string
+	DescriptionShort  string
+	DescriptionText   string
+	Author            sql.NullString
+	IsPrerelease      bool
+	IsSecurity        bool
+	IsBreaking        bool
+	HasDeprecation    bool
+	HasMigrationGuide bool
+	VulnerabilityIds  string
+	MajorVersion      sql.NullInt32
+	ReleasedAt        time.Time
+	GithubCreatedAt   sql.NullTime
+	EditedAt          sql.NullTime
+	UpdatedAt         time.Time
+	Hash              uint64
+	ID                int32
 }
 
 func (q *Queries) UpdateRelease(ctx context.Context, arg UpdateReleaseParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateRelease,
 		arg.GithubID,
+		arg.TagName,
 		arg.Name,
 		arg.Url,
 		arg.Description,
 		arg.DescriptionShort,
+		arg.DescriptionText,
 		arg.Author,
 		arg.IsPrerelease,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.HasDeprecation,
+		arg.HasMigrationGuide,
+		arg.VulnerabilityIds,
+		arg.MajorVersion,
 		arg.ReleasedAt,
+		arg.GithubCreatedAt,
+		arg.EditedAt,
 		arg.UpdatedAt,
 		arg.Hash,
 		arg.ID,
 	)
 }
 
+const updateReleaseSignals = `-- name: UpdateReleaseSignals :exec
+UPDATE releases
+SET
+  is_security = ? OR EXISTS (
+    SELECT 1 FROM release_advisories WHERE release_advisories.release_id = releases.id
+  ),
+  is_breaking = ?,
+  has_deprecation = ?,
+  has_migration_guide = ?,
+  vulnerability_ids = ?,
+  signals_detected = true
+WHERE
+  id = ?
+`
+
+type UpdateReleaseSignalsParams struct {
+	IsSecurity        bool
+	IsBreaking        bool
+	HasDeprecation    bool
+	HasMigrationGuide bool
+	VulnerabilityIds  string
+	ID                int32
+}
+
+func (q *Queries) UpdateReleaseSignals(ctx context.Context, arg UpdateReleaseSignalsParams) error {
+	_, err := q.db.ExecContext(ctx, updateReleaseSignals,
+		arg.IsSecurity,
+		arg.IsBreaking,
+		arg.HasDeprecation,
+		arg.HasMigrationGuide,
+		arg.VulnerabilityIds,
+		arg.ID,
+	)
+	return err
+}
+
 const updateRepository = `-- name: UpdateRepository :execresult
 UPDATE repositories
 SET
@@ -769,7 +4013,7 @@ type UpdateRepositoryParams struct {
 	Private      bool
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
-	LastSyncedAt time.Time
+	LastSyncedAt sql.NullTime
 	Hash         uint64
 	ID           int32
 }
@@ -788,6 +4032,50 @@ func (q *Queries) UpdateRepository(ctx context.Context, arg UpdateRepositoryPara
 	)
 }
 
+const updateRepositoryAdvisoriesSyncedAt = `-- name: UpdateRepositoryAdvisoriesSyncedAt :exec
+UPDATE repositories
+SET
+  advisories_synced_at = ?
+WHERE
+  id = ?
+`
+
+type UpdateRepositoryAdvisoriesSyncedAtParams struct {
+	AdvisoriesSyncedAt sql.NullTime
+	ID                 int32
+}
+
+func (q *Queries) UpdateRepositoryAdvisoriesSyncedAt(ctx context.Context, arg UpdateRepositoryAdvisoriesSyncedAtParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepositoryAdvisoriesSyncedAt, arg.AdvisoriesSyncedAt, arg.ID)
+	return err
+}
+
+const updateRepositoryGroupName = `-- name: UpdateRepositoryGroupName :execresult
+UPDATE repository_groups
+SET
+  name = ?,
+  updated_at = ?
+WHERE
+  id = ?
+  AND user_id = ?
+`
+
+type UpdateRepositoryGroupNameParams struct {
+	Name      string
+	UpdatedAt time.Time
+	ID        int32
+	UserID    int32
+}
+
+func (q *Queries) UpdateRepositoryGroupName(ctx context.Context, arg UpdateRepositoryGroupNameParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateRepositoryGroupName,
+		arg.Name,
+		arg.UpdatedAt,
+		arg.ID,
+		arg.UserID,
+	)
+}
+
 const updateRepositoryStar = `-- name: UpdateRepositoryStar :execresult
 UPDATE repository_stars
 SET
@@ -807,6 +4095,138 @@ func (q *Queries) UpdateRepositoryStar(ctx context.Context, arg UpdateRepository
 	return q.db.ExecContext(ctx, updateRepositoryStar, arg.UpdatedAt, arg.RepositoryID, arg.UserID)
 }
 
+const updateRepositoryStarMuted = `-- name: UpdateRepositoryStarMuted :execresult
+UPDATE repository_stars
+SET
+  is_muted = ?
+WHERE
+  repository_id = ?
+  AND user_id = ?
+`
+
+type UpdateRepositoryStarMutedParams struct {
+	IsMuted      bool
+	RepositoryID int32
+	UserID       int32
+}
+
+func (q *Queries) UpdateRepositoryStarMuted(ctx context.Context, arg UpdateRepositoryStarMutedParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateRepositoryStarMuted, arg.IsMuted, arg.RepositoryID, arg.UserID)
+}
+
+const updateRepositoryStarSnooze = `-- name: UpdateRepositoryStarSnooze :execresult
+UPDATE repository_stars
+SET
+  snoozed_until = ?,
+  snoozed_until_major = ?
+WHERE
+  repository_id = ?
+  AND user_id = ?
+`
+
+type UpdateRepositoryStarSnoozeParams struct {
+	SnoozedUntil      sql.NullTime
+	SnoozedUntilMajor sql.NullInt32
+	RepositoryID      int32
+	UserID            int32
+}
+
+func (q *Queries) UpdateRepositoryStarSnooze(ctx context.Context, arg UpdateRepositoryStarSnoozeParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateRepositoryStarSnooze,
+		arg.SnoozedUntil,
+		arg.SnoozedUntilMajor,
+		arg.RepositoryID,
+		arg.UserID,
+	)
+}
+
+const updateRepositorySyncSchedule = `-- name: UpdateRepositorySyncSchedule :exec
+UPDATE repositories
+SET
+  last_synced_at = ?,
+  next_sync_at = ?
+WHERE
+  id = ?
+`
+
+type UpdateRepositorySyncScheduleParams struct {
+	LastSyncedAt sql.NullTime
+	NextSyncAt   sql.NullTime
+	ID           int32
+}
+
+func (q *Queries) UpdateRepositorySyncSchedule(ctx context.Context, arg UpdateRepositorySyncScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepositorySyncSchedule, arg.LastSyncedAt, arg.NextSyncAt, arg.ID)
+	return err
+}
+
+const updateStarList = `-- name: UpdateStarList :exec
+UPDATE star_lists
+SET
+  name = ?,
+  slug = ?,
+  updated_at = ?
+WHERE
+  id = ?
+`
+
+type UpdateStarListParams struct {
+	Name      string
+	Slug      string
+	UpdatedAt time.Time
+	ID        int32
+}
+
+func (q *Queries) UpdateStarList(ctx context.Context, arg UpdateStarListParams) error {
+	_, err := q.db.ExecContext(ctx, updateStarList,
+		arg.Name,
+		arg.Slug,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateUserGithubScopes = `-- name: UpdateUserGithubScopes :exec
+UPDATE users
+SET
+  github_scopes = ?,
+  sync_private = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserGithubScopesParams struct {
+	GithubScopes string
+	SyncPrivate  bool
+	ID           int32
+}
+
+func (q *Queries) UpdateUserGithubScopes(ctx context.Context, arg UpdateUserGithubScopesParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserGithubScopes, arg.GithubScopes, arg.SyncPrivate, arg.ID)
+	return err
+}
+
+const updateUserIdentityLogin = `-- name: UpdateUserIdentityLogin :exec
+UPDATE user_identities
+SET
+  email = ?,
+  last_login_at = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserIdentityLoginParams struct {
+	Email       string
+	LastLoginAt time.Time
+	ID          int32
+}
+
+func (q *Queries) UpdateUserIdentityLogin(ctx context.Context, arg UpdateUserIdentityLoginParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserIdentityLogin, arg.Email, arg.LastLoginAt, arg.ID)
+	return err
+}
+
 const updateUserIsPublic = `-- name: UpdateUserIsPublic :exec
 UPDATE users
 SET
@@ -843,6 +4263,80 @@ func (q *Queries) UpdateUserOnboarded(ctx context.Context, arg UpdateUserOnboard
 	return err
 }
 
+const updateUserPrivateFeedID = `-- name: UpdateUserPrivateFeedID :exec
+UPDATE users
+SET
+  private_feed_id = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserPrivateFeedIDParams struct {
+	PrivateFeedID sql.NullString
+	ID            int32
+}
+
+func (q *Queries) UpdateUserPrivateFeedID(ctx context.Context, arg UpdateUserPrivateFeedIDParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserPrivateFeedID, arg.PrivateFeedID, arg.ID)
+	return err
+}
+
+const updateUserReadAllAt = `-- name: UpdateUserReadAllAt :exec
+UPDATE users
+SET
+  read_all_at = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserReadAllAtParams struct {
+	ReadAllAt sql.NullTime
+	ID        int32
+}
+
+func (q *Queries) UpdateUserReadAllAt(ctx context.Context, arg UpdateUserReadAllAtParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserReadAllAt, arg.ReadAllAt, arg.ID)
+	return err
+}
+
+const updateUserReleaseHistory = `-- name: UpdateUserReleaseHistory :exec
+UPDATE users
+SET
+  release_fetch_depth = ?,
+  release_retention = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserReleaseHistoryParams struct {
+	ReleaseFetchDepth sql.NullInt32
+	ReleaseRetention  sql.NullInt32
+	ID                int32
+}
+
+func (q *Queries) UpdateUserReleaseHistory(ctx context.Context, arg UpdateUserReleaseHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserReleaseHistory, arg.ReleaseFetchDepth, arg.ReleaseRetention, arg.ID)
+	return err
+}
+
+const updateUserSyncPrivate = `-- name: UpdateUserSyncPrivate :exec
+UPDATE users
+SET
+  sync_private = ?
+WHERE
+  id = ?
+`
+
+type UpdateUserSyncPrivateParams struct {
+	SyncPrivate bool
+	ID          int32
+}
+
+func (q *Queries) UpdateUserSyncPrivate(ctx context.Context, arg UpdateUserSyncPrivateParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserSyncPrivate, arg.SyncPrivate, arg.ID)
+	return err
+}
+
 const updateUserSyncedAt = `-- name: UpdateUserSyncedAt :exec
 UPDATE users
 SET
@@ -878,3 +4372,130 @@ func (q *Queries) UpdateUserToken(ctx context.Context, arg UpdateUserTokenParams
 	_, err := q.db.ExecContext(ctx, updateUserToken, arg.GithubToken, arg.ID)
 	return err
 }
+
+const upsertBookmark = `-- name: UpsertBookmark :exec
+INSERT INTO
+  bookmarks (user_id, release_id, note, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  note = VALUES(note),
+  updated_at = VALUES(updated_at)
+`
+
+type UpsertBookmarkParams struct {
+	UserID    int32
+	ReleaseID int32
+	Note      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpsertBookmark(ctx context.Context, arg UpsertBookmarkParams) error {
+	_, err := q.db.ExecContext(ctx, upsertBookmark,
+		arg.UserID,
+		arg.ReleaseID,
+		arg.Note,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const upsertReleaseAdvisory = `-- name: UpsertReleaseAdvisory :exec
+INSERT INTO
+  release_advisories (release_id, ghsa_id, cve_id, severity, summary, url, published_at, created_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  cve_id = VALUES(cve_id),
+  severity = VALUES(severity),
+  summary = VALUES(summary),
+  url = VALUES(url)
+`
+
+type UpsertReleaseAdvisoryParams struct {
+	ReleaseID   int32
+	GhsaID      string
+	CveID       sql.NullString
+	Severity    string
+	Summary     string
+	Url         string
+	PublishedAt time.Time
+	CreatedAt   time.Time
+}
+
+func (q *Queries) UpsertReleaseAdvisory(ctx context.Context, arg UpsertReleaseAdvisoryParams) error {
+	_, err := q.db.ExecContext(ctx, upsertReleaseAdvisory,
+		arg.ReleaseID,
+		arg.GhsaID,
+		arg.CveID,
+		arg.Severity,
+		arg.Summary,
+		arg.Url,
+		arg.PublishedAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const upsertReleaseAsset = `-- name: UpsertReleaseAsset :exec
+INSERT INTO
+  release_assets (release_id, github_id, name, content_type, size, download_count, url, created_at, updated_at)
+VALUES
+  (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  name = VALUES(name),
+  content_type = VALUES(content_type),
+  size = VALUES(size),
+  download_count = VALUES(download_count),
+  url = VALUES(url),
+  updated_at = VALUES(updated_at)
+`
+
+type UpsertReleaseAssetParams struct {
+	ReleaseID     int32
+	GithubID      string
+	Name          string
+	ContentType   string
+	Size          int64
+	DownloadCount int32
+	Url           string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (q *Queries) UpsertReleaseAsset(ctx context.Context, arg UpsertReleaseAssetParams) error {
+	_, err := q.db.ExecContext(ctx, upsertReleaseAsset,
+		arg.ReleaseID,
+		arg.GithubID,
+		arg.Name,
+		arg.ContentType,
+		arg.Size,
+		arg.DownloadCount,
+		arg.Url,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const upsertStarListRepository = `-- name: UpsertStarListRepository :exec
+INSERT INTO
+  star_list_repositories (list_id, repository_id, updated_at)
+VALUES
+  (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  updated_at = VALUES(updated_at)
+`
+
+type UpsertStarListRepositoryParams struct {
+	ListID       int32
+	RepositoryID int32
+	UpdatedAt    time.Time
+}
+
+func (q *Queries) UpsertStarListRepository(ctx context.Context, arg UpsertStarListRepositoryParams) error {
+	_, err := q.db.ExecContext(ctx, upsertStarListRepository, arg.ListID, arg.RepositoryID, arg.UpdatedAt)
+	return err
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/gen/api/v1/apiv1connect"
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+// apiTokenPrefix marks personal API tokens, which tells them apart from JWTs and makes leaked tokens easy to scan for
+const apiTokenPrefix = "ro_"
+
+var (
+	ApiTokenScopeRead  = "read"
+	ApiTokenScopeWrite = "write"
+)
+
+// apiTokenForbidden marks procedures no API token may call, whatever its scopes
+const apiTokenForbidden = ""
+
+// apiTokenProcedureScopes lists the scope every procedure needs when called with an API token. Procedures that are missing are denied,
+// so new RPCs stay unreachable for API tokens until they are added here.
+// Procedures that manage credentials or the whole account need a real login, so a leaked API token can't create more of itself,
+// delete the account or hand out everything about the user.
+var apiTokenProcedureScopes = map[string]string{
+	apiv1connect.ApiServiceGetRepositoriesProcedure:                 ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetMyUserProcedure:                       ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetBookmarksProcedure:                    ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetMutedRepositoriesProcedure:            ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetGroupsProcedure:                       ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetListsProcedure:                        ApiTokenScopeRead,
+	apiv1connect.ApiServiceSearchReleasesProcedure:                  ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetReleaseProcedure:                      ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetReleaseDiffProcedure:                  ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetReleaseHistorySettingsProcedure:       ApiTokenScopeRead,
+	apiv1connect.ApiServiceGetPrivateRepositorySettingsProcedure:    ApiTokenScopeRead,
+	apiv1connect.ApiServiceSyncProcedure:                            ApiTokenScopeWrite,
+	apiv1connect.ApiServiceToogleUserPublicFeedProcedure:            ApiTokenScopeWrite,
+	apiv1connect.ApiServiceToggleUserOnboardedProcedure:             ApiTokenScopeWrite,
+	apiv1connect.ApiServiceMarkReleaseReadProcedure:                 ApiTokenScopeWrite,
+	apiv1connect.ApiServiceMarkRepositoryReadProcedure:              ApiTokenScopeWrite,
+	apiv1connect.ApiServiceMarkAllReadProcedure:                     ApiTokenScopeWrite,
+	apiv1connect.ApiServiceAddBookmarkProcedure:                     ApiTokenScopeWrite,
+	apiv1connect.ApiServiceRemoveBookmarkProcedure:                  ApiTokenScopeWrite,
+	apiv1connect.ApiServiceMuteRepositoryProcedure:                  ApiTokenScopeWrite,
+	apiv1connect.ApiServiceSnoozeRepositoryProcedure:                ApiTokenScopeWrite,
+	apiv1connect.ApiServiceCreateGroupProcedure:                     ApiTokenScopeWrite,
+	apiv1connect.ApiServiceRenameGroupProcedure:                     ApiTokenScopeWrite,
+	apiv1connect.ApiServiceDeleteGroupProcedure:                     ApiTokenScopeWrite,
+	apiv1connect.ApiServiceAddRepositoryToGroupProcedure:            ApiTokenScopeWrite,
+	apiv1connect.ApiServiceRemoveRepositoryFromGroupProcedure:       ApiTokenScopeWrite,
+	apiv1connect.ApiServiceUpdateReleaseHistorySettingsProcedure:    ApiTokenScopeWrite,
+	apiv1connect.ApiServiceLogoutProcedure:                          apiTokenForbidden,
+	apiv1connect.ApiServiceCreateApiTokenProcedure:                  apiTokenForbidden,
+	apiv1connect.ApiServiceGetApiTokensProcedure:                    apiTokenForbidden,
+	apiv1connect.ApiServiceRevokeApiTokenProcedure:                  apiTokenForbidden,
+	apiv1connect.ApiServiceGetSessionsProcedure:                     apiTokenForbidden,
+	apiv1connect.ApiServiceRevokeSessionProcedure:                   apiTokenForbidden,
+	apiv1connect.ApiServiceRevokeAllSessionsProcedure:               apiTokenForbidden,
+	apiv1connect.ApiServiceUpdatePrivateRepositorySettingsProcedure: apiTokenForbidden,
+	apiv1connect.ApiServiceDeleteMyAccountProcedure:                 apiTokenForbidden,
+	apiv1connect.ApiServiceExportMyDataProcedure:                    apiTokenForbidden,
+	apiv1connect.AuthServiceRefreshTokenProcedure:                   apiTokenForbidden,
+	apiv1connect.AuthServiceGetLoginProvidersProcedure:              apiTokenForbidden,
+}
+
+// apiTokenTouchInterval limits how often the last used timestamp of a token is written
+const apiTokenTouchInterval = time.Minute
+
+// generateApiToken creates a new random API token, only its hash and prefix are stored
+func generateApiToken() (token string, hash string, prefix string, err error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", "", "", errors.Join(err, errors.New("could not generate api token"))
+	}
+
+	token = apiTokenPrefix + base64.RawURLEncoding.EncodeToString(tokenBytes)
+	return token, hashApiToken(token), token[:len(apiTokenPrefix)+4], nil
+}
+
+// hashApiToken hashes a token for lookups, tokens are random enough that a fast hash is fine
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// apiTokenAllows reports whether a token with scopes may call procedure, the write scope includes reading
+func apiTokenAllows(procedure string, scopes []string) bool {
+	scope, ok := apiTokenProcedureScopes[procedure]
+	if !ok || scope == apiTokenForbidden {
+		return false
+	}
+
+	return slices.Contains(scopes, scope) || slices.Contains(scopes, ApiTokenScopeWrite)
+}
+
+// validateApiToken returns the user of an API token, if the token may call procedure
+func validateApiToken(ctx context.Context, queries *repository.Queries, token string, procedure string) (int, error) {
+	apiToken, err := queries.GetApiTokenByHash(ctx, hashApiToken(token))
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.New("unknown api token")
+	} else if err != nil {
+		return 0, err
+	}
+
+	if apiToken.ExpiresAt.Valid && apiToken.ExpiresAt.Time.Before(time.Now()) {
+		return 0, errors.New("api token expired")
+	}
+
+	if !apiTokenAllows(procedure, strings.Split(apiToken.Scopes, ",")) {
+		return 0, errors.New("api token is missing the scope for " + procedure)
+	}
+
+	err = queries.TouchApiToken(ctx, repository.TouchApiTokenParams{
+		LastUsedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+		ID:            apiToken.ID,
+		TouchedBefore: sql.NullTime{Time: time.Now().Add(-apiTokenTouchInterval), Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(apiToken.UserID), nil
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/benjasper/releases.one/internal/gen/api/v1"
+	"github.com/benjasper/releases.one/internal/gen/api/v1/apiv1connect"
+)
+
+func TestGenerateApiToken(t *testing.T) {
+	token, hash, prefix, err := generateApiToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(token, apiTokenPrefix) || !strings.HasPrefix(token, prefix) {
+		t.Fatalf("unexpected token %q with prefix %q", token, prefix)
+	}
+
+	if hash != hashApiToken(token) || strings.Contains(hash, token) {
+		t.Fatal("hash does not match the token")
+	}
+
+	otherToken, _, _, err := generateApiToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if otherToken == token {
+		t.Fatal("token is not random")
+	}
+}
+
+func TestApiTokenAllows(t *testing.T) {
+	read := []string{ApiTokenScopeRead}
+	write := []string{ApiTokenScopeWrite}
+
+	if !apiTokenAllows(apiv1connect.ApiServiceGetRepositoriesProcedure, read) {
+		t.Fatal("expected read scope to allow reading the timeline")
+	}
+
+	if !apiTokenAllows(apiv1connect.ApiServiceSearchReleasesProcedure, read) {
+		t.Fatal("expected read scope to allow searching")
+	}
+
+	if apiTokenAllows(apiv1connect.ApiServiceMarkReleaseReadProcedure, read) {
+		t.Fatal("expected read scope to forbid changes")
+	}
+
+	if !apiTokenAllows(apiv1connect.ApiServiceMarkReleaseReadProcedure, write) {
+		t.Fatal("expected write scope to allow changes")
+	}
+
+	if apiTokenAllows(apiv1connect.ApiServiceCreateApiTokenProcedure, write) || apiTokenAllows(apiv1connect.ApiServiceGetApiTokensProcedure, read) {
+		t.Fatal("expected api tokens to never manage api tokens")
+	}
+
+	if apiTokenAllows(apiv1connect.ApiServiceGetRepositoriesProcedure, []string{""}) {
+		t.Fatal("expected no scope to forbid everything")
+	}
+}
+
+func TestApiTokenProcedureScopes(t *testing.T) {
+	services := v1.File_api_v1_api_proto.Services()
+	for i := range services.Len() {
+		service := services.Get(i)
+		methods := service.Methods()
+		for j := range methods.Len() {
+			procedure := "/" + string(service.FullName()) + "/" + string(methods.Get(j).Name())
+			if _, ok := apiTokenProcedureScopes[procedure]; !ok {
+				t.Errorf("%s has no api token scope, add it to apiTokenProcedureScopes", procedure)
+			}
+		}
+	}
+}
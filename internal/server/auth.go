@@ -1,13 +1,21 @@
 package server
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/benjasper/releases.one/internal/repository"
+	"github.com/benjasper/releases.one/pkg/jwtkeys"
 	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
 )
 
 var ErrInvalidToken = errors.New("invalid token")
@@ -19,32 +27,48 @@ var (
 
 var Issuer = "releases.one"
 
-func GenerateTokens(user *repository.User, signingKey []byte) (accessToken, refreshToken string, accessTokenExpiresAt, refreshTokenExpiresAt *time.Time, err error) {
+// refreshTokenLifetime is how long a session stays alive without being used, every refresh extends it
+const refreshTokenLifetime = time.Hour * 24 * 365
+
+// tokenClaims are the claims of access and refresh tokens, both belong to a session
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid"`
+}
+
+// GenerateTokens issues an access token and a refresh token for a session, the refresh token is identified by refreshTokenID
+func GenerateTokens(user *repository.User, sessionID string, refreshTokenID string, signingKeys *jwtkeys.Keyring) (accessToken, refreshToken string, accessTokenExpiresAt, refreshTokenExpiresAt *time.Time, err error) {
 	// Create the access token
 	accessTokenExpiration := time.Now().Add(time.Hour * 2)
-	claims := &jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(accessTokenExpiration),
-		Issuer:    Issuer,
-		Subject:   strconv.Itoa(int(user.ID)),
-		Audience:  jwt.ClaimStrings{AudienceAccess},
+	claims := &tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(accessTokenExpiration),
+			Issuer:    Issuer,
+			Subject:   strconv.Itoa(int(user.ID)),
+			Audience:  jwt.ClaimStrings{AudienceAccess},
+		},
+		SessionID: sessionID,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	accessToken, err = token.SignedString(signingKey)
+	accessToken, err = signingKeys.Sign(claims)
 	if err != nil {
 		return "", "", nil, nil, errors.Join(err, errors.New("could not sign access token"))
 	}
 
 	// Create the refresh token
-	refreshTokenExpiration := time.Now().Add(time.Hour * 24 * 365)
-	refreshTokenClaims := &jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(refreshTokenExpiration),
-		Issuer:    Issuer,
-		Subject:   strconv.Itoa(int(user.ID)),
-		Audience:  jwt.ClaimStrings{AudienceRefresh},
+	refreshTokenExpiration := time.Now().Add(refreshTokenLifetime)
+	refreshTokenClaims := &tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(refreshTokenExpiration),
+			Issuer:    Issuer,
+			Subject:   strconv.Itoa(int(user.ID)),
+			Audience:  jwt.ClaimStrings{AudienceRefresh},
+			ID:        refreshTokenID,
+		},
+		SessionID: sessionID,
 	}
 
-	refreshToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, refreshTokenClaims).SignedString(signingKey)
+	refreshToken, err = signingKeys.Sign(refreshTokenClaims)
 	if err != nil {
 		return "", "", nil, nil, errors.Join(err, errors.New("could not sign refresh token"))
 	}
@@ -52,63 +76,159 @@ func GenerateTokens(user *repository.User, signingKey []byte) (accessToken, refr
 	return accessToken, refreshToken, &accessTokenExpiration, &refreshTokenExpiration, nil
 }
 
-func parseToken(tokenString string, signingKey []byte) (*jwt.Token, *jwt.RegisteredClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return signingKey, nil
-	})
+func parseToken(tokenString string, signingKeys *jwtkeys.Keyring) (*jwt.Token, *tokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &tokenClaims{}, signingKeys.Keyfunc)
 
 	if err != nil {
 		return nil, nil, errors.Join(err, ErrInvalidToken)
-	} else if claims, ok := token.Claims.(*jwt.RegisteredClaims); ok {
+	} else if claims, ok := token.Claims.(*tokenClaims); ok {
 		return token, claims, nil
 	} else {
 		return nil, nil, errors.New("could not parse claims unknown claims type")
 	}
 }
 
-func validateAccessTokenClaims(tokenString string, signingKey []byte) (int, error) {
-	_, claims, err := parseToken(tokenString, signingKey)
+// validateAccessTokenClaims returns the user and the session of an access token
+func validateAccessTokenClaims(tokenString string, signingKeys *jwtkeys.Keyring) (int, string, error) {
+	_, claims, err := parseToken(tokenString, signingKeys)
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
 	if claims.Issuer != Issuer {
-		return 0, errors.New("invalid issuer")
+		return 0, "", errors.New("invalid issuer")
 	}
 
 	if !slices.Contains(claims.Audience, AudienceAccess) {
-		return 0, errors.New("invalid audience")
+		return 0, "", errors.New("invalid audience")
+	}
+
+	// Tokens from before sessions existed can't be revoked, so they aren't accepted anymore
+	if claims.SessionID == "" {
+		return 0, "", errors.New("missing session")
 	}
 
 	userID, err := strconv.Atoi(claims.Subject)
 	if err != nil {
-		return 0, errors.Join(err, errors.New("invalid subject"))
+		return 0, "", errors.Join(err, errors.New("invalid subject"))
 	}
 
-	return userID, nil
+	return userID, claims.SessionID, nil
 }
 
-func validateRefreshTokenClaims(tokenString string, signingKey []byte) (int,error) {
-	_, claims, err := parseToken(tokenString, signingKey)
+// validateRefreshTokenClaims returns the user, the session and the ID of a refresh token
+func validateRefreshTokenClaims(tokenString string, signingKeys *jwtkeys.Keyring) (int, string, string, error) {
+	_, claims, err := parseToken(tokenString, signingKeys)
 	if err != nil {
-		return 0, err
+		return 0, "", "", err
 	}
 
 	if claims.Issuer != Issuer {
-		return 0, errors.New("invalid issuer")
+		return 0, "", "", errors.New("invalid issuer")
 	}
 
 	if !slices.Contains(claims.Audience, AudienceRefresh) {
-		return 0, errors.New("invalid audience")
+		return 0, "", "", errors.New("invalid audience")
+	}
+
+	if claims.SessionID == "" || claims.ID == "" {
+		return 0, "", "", errors.New("missing session")
 	}
 
 	userID, err := strconv.Atoi(claims.Subject)
 	if err != nil {
-		return 0, errors.Join(err, errors.New("invalid subject"))
+		return 0, "", "", errors.Join(err, errors.New("invalid subject"))
+	}
+
+	return userID, claims.SessionID, claims.ID, nil
+}
+
+// AudienceLogin is the audience of the cookie that carries a login attempt from the redirect to the login provider to the callback
+var AudienceLogin = "login.releases.one"
+
+// loginStateLifetime is how long a user has to complete the login at the login provider
+const loginStateLifetime = 10 * time.Minute
+
+type loginStateClaims struct {
+	jwt.RegisteredClaims
+	State        string `json:"state"`
+	CodeVerifier string `json:"code_verifier"`
+	// Nonce binds the ID token of an OpenID Connect login to this attempt
+	Nonce    string `json:"nonce"`
+	ReturnTo string `json:"return_to,omitempty"`
+	// LinkUserID is the logged in user a GitHub account is linked to, instead of logging in with it
+	LinkUserID int `json:"link_user_id,omitempty"`
+}
+
+// generateLoginState creates a random OAuth state, PKCE verifier and nonce, bound to a signed cookie value that expires with the login attempt
+func generateLoginState(returnTo string, linkUserID int, signingKeys *jwtkeys.Keyring) (*loginStateClaims, string, error) {
+	randomBytes := make([]byte, 64)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, "", errors.Join(err, errors.New("could not generate state"))
+	}
+
+	claims := &loginStateClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(loginStateLifetime)),
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{AudienceLogin},
+		},
+		State:        base64.RawURLEncoding.EncodeToString(randomBytes[:32]),
+		CodeVerifier: oauth2.GenerateVerifier(),
+		Nonce:        base64.RawURLEncoding.EncodeToString(randomBytes[32:]),
+		ReturnTo:     returnTo,
+		LinkUserID:   linkUserID,
+	}
+
+	cookieValue, err := signingKeys.Sign(claims)
+	if err != nil {
+		return nil, "", errors.Join(err, errors.New("could not sign login state"))
+	}
+
+	return claims, cookieValue, nil
+}
+
+// validateLoginState checks the state the login provider sent back against the one in the login cookie
+func validateLoginState(cookieValue string, state string, signingKeys *jwtkeys.Keyring) (*loginStateClaims, error) {
+	token, err := jwt.ParseWithClaims(cookieValue, &loginStateClaims{}, signingKeys.Keyfunc, jwt.WithIssuer(Issuer), jwt.WithAudience(AudienceLogin), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, errors.Join(err, ErrInvalidToken)
+	}
+
+	claims, ok := token.Claims.(*loginStateClaims)
+	if !ok {
+		return nil, errors.New("could not parse claims unknown claims type")
+	}
+
+	if claims.State == "" || subtle.ConstantTimeCompare([]byte(claims.State), []byte(state)) != 1 {
+		return nil, errors.New("state does not match")
+	}
+
+	return claims, nil
+}
+
+// resolveReturnTo resolves where to send the user after logging in, relative to base. Only URLs on an allowed origin are accepted,
+// so the login can't be used as an open redirect.
+func resolveReturnTo(returnTo string, base *url.URL, allowedOrigins []string) (*url.URL, error) {
+	// Browsers treat backslashes like slashes, which would slip past the origin check
+	if strings.ContainsAny(returnTo, "\\\r\n\t") {
+		return nil, errors.New("invalid return to")
+	}
+
+	parsed, err := url.Parse(returnTo)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid return to"))
+	}
+
+	resolved := base.ResolveReference(parsed)
+	if resolved.Scheme != "http" && resolved.Scheme != "https" {
+		return nil, errors.New("invalid return to")
+	}
+
+	origin := resolved.Scheme + "://" + resolved.Host
+	if !slices.Contains(allowedOrigins, origin) {
+		return nil, fmt.Errorf("return to origin %s is not allowed", origin)
 	}
 
-	return userID, nil
+	return resolved, nil
 }
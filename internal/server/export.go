@@ -0,0 +1,305 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+// dataExport is everything stored about a user, as handed out by ExportMyData. Secrets like the GitHub token and API token
+// hashes are left out, they are of no use to the user and must never leave the server.
+type dataExport struct {
+	ExportedAt   time.Time             `json:"exported_at"`
+	User         exportedUser          `json:"user"`
+	Identities   []exportedIdentity    `json:"identities"`
+	Stars        []exportedStar        `json:"stars"`
+	Groups       []exportedGroup       `json:"groups"`
+	Lists        []exportedList        `json:"lists"`
+	Bookmarks    []exportedBookmark    `json:"bookmarks"`
+	ReadReleases []exportedReadRelease `json:"read_releases"`
+	Sessions     []exportedSession     `json:"sessions"`
+	ApiTokens    []exportedApiToken    `json:"api_tokens"`
+}
+
+type exportedUser struct {
+	ID                int32      `json:"id"`
+	Username          string     `json:"username"`
+	GithubID          *int64     `json:"github_id"`
+	GithubScopes      []string   `json:"github_scopes"`
+	PublicID          string     `json:"public_id"`
+	PrivateFeedID     *string    `json:"private_feed_id"`
+	IsPublic          bool       `json:"is_public"`
+	IsOnboarded       bool       `json:"is_onboarded"`
+	SyncPrivate       bool       `json:"sync_private"`
+	ReleaseFetchDepth *int32     `json:"release_fetch_depth"`
+	ReleaseRetention  *int32     `json:"release_retention"`
+	LastSyncedAt      time.Time  `json:"last_synced_at"`
+	ReadAllAt         *time.Time `json:"read_all_at"`
+}
+
+type exportedIdentity struct {
+	Issuer      string    `json:"issuer"`
+	Subject     string    `json:"subject"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+type exportedStar struct {
+	RepositoryID      int32      `json:"repository_id"`
+	Name              string     `json:"name"`
+	Url               string     `json:"url"`
+	Watching          bool       `json:"watching"`
+	IsMuted           bool       `json:"is_muted"`
+	SnoozedUntil      *time.Time `json:"snoozed_until"`
+	SnoozedUntilMajor *int32     `json:"snoozed_until_major"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type exportedGroup struct {
+	Name          string    `json:"name"`
+	RepositoryIDs []int32   `json:"repository_ids"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type exportedList struct {
+	Name          string  `json:"name"`
+	Slug          string  `json:"slug"`
+	RepositoryIDs []int32 `json:"repository_ids"`
+}
+
+type exportedBookmark struct {
+	ReleaseID      int32     `json:"release_id"`
+	RepositoryName string    `json:"repository_name"`
+	Name           string    `json:"name"`
+	Url            string    `json:"url"`
+	Note           string    `json:"note"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type exportedReadRelease struct {
+	ReleaseID    int32     `json:"release_id"`
+	RepositoryID int32     `json:"repository_id"`
+	Name         string    `json:"name"`
+	Url          string    `json:"url"`
+	ReadAt       time.Time `json:"read_at"`
+}
+
+type exportedSession struct {
+	ID         string     `json:"id"`
+	UserAgent  string     `json:"user_agent"`
+	IpAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+type exportedApiToken struct {
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// exportUserData collects everything stored about a user
+func exportUserData(ctx context.Context, queries *repository.Queries, user *repository.User) (*dataExport, error) {
+	export := &dataExport{
+		ExportedAt:   time.Now(),
+		User:         exportUser(user),
+		Identities:   []exportedIdentity{},
+		Stars:        []exportedStar{},
+		Groups:       []exportedGroup{},
+		Lists:        []exportedList{},
+		Bookmarks:    []exportedBookmark{},
+		ReadReleases: []exportedReadRelease{},
+		Sessions:     []exportedSession{},
+		ApiTokens:    []exportedApiToken{},
+	}
+
+	identities, err := queries.GetUserIdentitiesForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		export.Identities = append(export.Identities, exportedIdentity{
+			Issuer:      identity.Issuer,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			CreatedAt:   identity.CreatedAt,
+			LastLoginAt: identity.LastLoginAt,
+		})
+	}
+
+	stars, err := queries.GetStarsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, star := range stars {
+		export.Stars = append(export.Stars, exportedStar{
+			RepositoryID:      star.ID,
+			Name:              star.Name,
+			Url:               star.Url,
+			Watching:          repository.RepositoryStarType(star.Type) == repository.RepositoryStarTypeWatch,
+			IsMuted:           star.IsMuted,
+			SnoozedUntil:      nullableTime(star.SnoozedUntil),
+			SnoozedUntilMajor: nullableInt32(star.SnoozedUntilMajor),
+			CreatedAt:         star.CreatedAt,
+		})
+	}
+
+	groups, err := queries.GetRepositoryGroupsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	groupMembers, err := queries.GetRepositoryGroupMembersForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		exported := exportedGroup{Name: group.Name, RepositoryIDs: []int32{}, CreatedAt: group.CreatedAt}
+		for _, member := range groupMembers {
+			if member.GroupID == group.ID {
+				exported.RepositoryIDs = append(exported.RepositoryIDs, member.RepositoryID)
+			}
+		}
+
+		export.Groups = append(export.Groups, exported)
+	}
+
+	lists, err := queries.GetStarListsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	listRepositories, err := queries.GetStarListRepositoriesForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range lists {
+		exported := exportedList{Name: list.Name, Slug: list.Slug, RepositoryIDs: []int32{}}
+		for _, listRepository := range listRepositories {
+			if listRepository.ListID == list.ID {
+				exported.RepositoryIDs = append(exported.RepositoryIDs, listRepository.RepositoryID)
+			}
+		}
+
+		export.Lists = append(export.Lists, exported)
+	}
+
+	bookmarks, err := queries.GetBookmarksForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, bookmark := range bookmarks {
+		export.Bookmarks = append(export.Bookmarks, exportedBookmark{
+			ReleaseID:      bookmark.ID,
+			RepositoryName: bookmark.RepositoryName,
+			Name:           bookmark.Name,
+			Url:            bookmark.Url,
+			Note:           bookmark.Note,
+			CreatedAt:      bookmark.BookmarkedAt,
+			UpdatedAt:      bookmark.BookmarkUpdatedAt,
+		})
+	}
+
+	releaseReads, err := queries.GetReleaseReadsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, releaseRead := range releaseReads {
+		export.ReadReleases = append(export.ReadReleases, exportedReadRelease{
+			ReleaseID:    releaseRead.ReleaseID,
+			RepositoryID: releaseRead.RepositoryID,
+			Name:         releaseRead.Name,
+			Url:          releaseRead.Url,
+			ReadAt:       releaseRead.CreatedAt,
+		})
+	}
+
+	sessions, err := queries.GetSessionsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, exportedSession{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IpAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			RevokedAt:  nullableTime(session.RevokedAt),
+		})
+	}
+
+	apiTokens, err := queries.GetApiTokensForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, apiToken := range apiTokens {
+		export.ApiTokens = append(export.ApiTokens, exportedApiToken{
+			Name:       apiToken.Name,
+			Prefix:     apiToken.TokenPrefix,
+			Scopes:     strings.Split(apiToken.Scopes, ","),
+			CreatedAt:  apiToken.CreatedAt,
+			LastUsedAt: nullableTime(apiToken.LastUsedAt),
+			ExpiresAt:  nullableTime(apiToken.ExpiresAt),
+		})
+	}
+
+	return export, nil
+}
+
+// exportUser describes the user row, without the GitHub token
+func exportUser(user *repository.User) exportedUser {
+	exported := exportedUser{
+		ID:                user.ID,
+		Username:          user.Username,
+		GithubScopes:      []string{},
+		PublicID:          user.PublicID,
+		PrivateFeedID:     nil,
+		IsPublic:          user.IsPublic,
+		IsOnboarded:       user.IsOnboarded,
+		SyncPrivate:       user.SyncPrivate,
+		ReleaseFetchDepth: nullableInt32(user.ReleaseFetchDepth),
+		ReleaseRetention:  nullableInt32(user.ReleaseRetention),
+		LastSyncedAt:      user.LastSyncedAt,
+		ReadAllAt:         nullableTime(user.ReadAllAt),
+	}
+
+	if user.GithubID.Valid {
+		exported.GithubID = &user.GithubID.Int64
+	}
+
+	if user.PrivateFeedID.Valid {
+		exported.PrivateFeedID = &user.PrivateFeedID.String
+	}
+
+	if user.GithubScopes != "" {
+		exported.GithubScopes = strings.Split(user.GithubScopes, ",")
+	}
+
+	return exported
+}
+
+func nullableTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Time
+}
+
+func nullableInt32(value sql.NullInt32) *int32 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int32
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+func TestExportUser(t *testing.T) {
+	user := &repository.User{
+		ID:            1,
+		Username:      "jane",
+		GithubID:      sql.NullInt64{Int64: 42, Valid: true},
+		GithubToken:   repository.GitHubToken{AccessToken: "gho_secret", RefreshToken: "ghr_secret"},
+		GithubScopes:  "read:user,repo",
+		PrivateFeedID: sql.NullString{String: "feed", Valid: true},
+	}
+
+	exported := exportUser(user)
+	if *exported.GithubID != 42 || *exported.PrivateFeedID != "feed" || len(exported.GithubScopes) != 2 {
+		t.Fatalf("unexpected export %+v", exported)
+	}
+
+	if exported.ReleaseRetention != nil || exported.ReadAllAt != nil {
+		t.Fatal("expected unset settings to be null")
+	}
+
+	encoded, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(encoded), "_secret") {
+		t.Fatalf("export contains the GitHub token: %s", encoded)
+	}
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/oidc"
+	"github.com/benjasper/releases.one/internal/repository"
+	"github.com/google/uuid"
+)
+
+// GetLoginWithOIDC starts a login with the configured OpenID Connect provider
+func (s *Server) GetLoginWithOIDC(w http.ResponseWriter, r *http.Request) {
+	if s.oidcProvider == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	returnTo := r.URL.Query().Get("return_to")
+	if returnTo != "" {
+		if _, err := s.resolveReturnTo(returnTo); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Return to is not allowed"))
+			return
+		}
+	}
+
+	loginState, cookieValue, err := generateLoginState(returnTo, 0, s.signingKeys)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to generate login state: %s", err.Error()))
+		http.Error(w, "Failed to generate login state", http.StatusInternalServerError)
+		return
+	}
+
+	s.setLoginStateCookie(w, "/api/oidc", cookieValue)
+
+	url := s.oidcProvider.AuthCodeURL(loginState.State, loginState.Nonce, loginState.CodeVerifier)
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// GetLoginWithOIDCCallback logs in the user of a verified ID token, users are identified by issuer and subject and created on their first login
+func (s *Server) GetLoginWithOIDCCallback(w http.ResponseWriter, r *http.Request) {
+	if s.oidcProvider == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	loginState, ok := s.consumeLoginState(w, r, "/api/oidc")
+	if !ok {
+		return
+	}
+
+	if errorCode := r.URL.Query().Get("error"); errorCode != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Login failed: " + errorCode))
+		return
+	}
+
+	claims, err := s.oidcProvider.Exchange(r.Context(), r.URL.Query().Get("code"), loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		slog.Info(fmt.Sprintf("Failed to verify OpenID Connect login: %s", err.Error()))
+		http.Error(w, "Failed to verify login", http.StatusUnauthorized)
+		return
+	}
+
+	var userID int32
+	identity, err := s.repository.GetUserIdentity(r.Context(), repository.GetUserIdentityParams{
+		Issuer:  s.oidcProvider.Issuer,
+		Subject: claims.Subject,
+	})
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		userID, err = s.createOIDCUser(r.Context(), claims)
+		if err != nil && repository.IsDuplicateEntry(err) {
+			// A concurrent first login of the same identity created the user already
+			identity, err = s.repository.GetUserIdentity(r.Context(), repository.GetUserIdentityParams{
+				Issuer:  s.oidcProvider.Issuer,
+				Subject: claims.Subject,
+			})
+			userID = identity.UserID
+		}
+		if err != nil {
+			http.Error(w, "Failed to create user: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	} else if err != nil {
+		http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	} else {
+		userID = identity.UserID
+
+		err = s.repository.UpdateUserIdentityLogin(r.Context(), repository.UpdateUserIdentityLoginParams{
+			Email:       claims.Email,
+			LastLoginAt: time.Now(),
+			ID:          identity.ID,
+		})
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to update user identity: %s", err.Error()))
+			http.Error(w, "Failed to update user: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	user, err := s.repository.GetUserByID(r.Context(), userID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.completeLogin(w, r, &user, loginState.ReturnTo)
+}
+
+// oidcUsername picks the name a new user is shown with, from the most to the least readable claim
+func oidcUsername(claims *oidc.Claims) string {
+	for _, name := range []string{claims.PreferredUsername, claims.Email, claims.Name} {
+		if name != "" {
+			return truncate(name, 255)
+		}
+	}
+
+	return truncate(claims.Subject, 255)
+}
+
+// createOIDCUser creates the user of an identity on its first login. The user and the identity are created together, so a
+// failed login doesn't leave a user behind that nobody can log in as. A concurrent first login of the same identity fails on
+// the unique issuer and subject with a duplicate entry error.
+func (s *Server) createOIDCUser(ctx context.Context, claims *oidc.Claims) (int32, error) {
+	username := oidcUsername(claims)
+	slog.Info("No user found, creating new user", "username", username)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	queries := s.repository.WithTx(tx)
+
+	// Users of an identity provider start without GitHub, they link their account afterwards to sync their stars
+	result, err := queries.CreateUser(ctx, repository.CreateUserParams{
+		GithubID:     sql.NullInt64{Valid: false},
+		Username:     username,
+		GithubToken:  repository.GitHubToken{},
+		LastSyncedAt: time.UnixMicro(0),
+		IsPublic:     false,
+		IsOnboarded:  false,
+		PublicID:     uuid.NewString(),
+		GithubScopes: "",
+		SyncPrivate:  false,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	err = queries.CreateUserIdentity(ctx, repository.CreateUserIdentityParams{
+		UserID:      int32(insertedID),
+		Issuer:      s.oidcProvider.Issuer,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		CreatedAt:   time.Now(),
+		LastLoginAt: time.Now(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(insertedID), tx.Commit()
+}
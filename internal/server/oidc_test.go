@@ -0,0 +1,26 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/benjasper/releases.one/internal/oidc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestOidcUsername(t *testing.T) {
+	tests := []struct {
+		claims   oidc.Claims
+		expected string
+	}{
+		{oidc.Claims{PreferredUsername: "jane", Email: "jane@example.com", Name: "Jane"}, "jane"},
+		{oidc.Claims{Email: "jane@example.com", Name: "Jane"}, "jane@example.com"},
+		{oidc.Claims{Name: "Jane"}, "Jane"},
+		{oidc.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "248289761001"}}, "248289761001"},
+	}
+
+	for _, test := range tests {
+		if username := oidcUsername(&test.claims); username != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, username)
+		}
+	}
+}
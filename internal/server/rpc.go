@@ -3,19 +3,26 @@ package server
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/authn"
 	"connectrpc.com/connect"
 	"github.com/benjasper/releases.one/internal/config"
 	apiv1 "github.com/benjasper/releases.one/internal/gen/api/v1"
+	"github.com/benjasper/releases.one/internal/github"
 	"github.com/benjasper/releases.one/internal/repository"
 	"github.com/benjasper/releases.one/internal/server/services"
+	"github.com/benjasper/releases.one/pkg/diff"
+	"github.com/benjasper/releases.one/pkg/jwtkeys"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,14 +30,16 @@ type RpcServer struct {
 	config      *config.Config
 	repository  *repository.Queries
 	syncService *services.SyncService
+	signingKeys *jwtkeys.Keyring
 	baseURL     *url.URL
 }
 
-func NewRpcServer(config *config.Config, repository *repository.Queries, syncService *services.SyncService, baseURL *url.URL) *RpcServer {
+func NewRpcServer(config *config.Config, repository *repository.Queries, syncService *services.SyncService, signingKeys *jwtkeys.Keyring, baseURL *url.URL) *RpcServer {
 	return &RpcServer{
 		config:      config,
 		repository:  repository,
 		syncService: syncService,
+		signingKeys: signingKeys,
 		baseURL:     baseURL,
 	}
 }
@@ -55,8 +64,8 @@ func (s *RpcServer) Sync(ctx context.Context, req *connect.Request[apiv1.SyncReq
 		return nil, errors.Join(err, errors.New("failed to retrieve user"))
 	}
 
-	// Only sync if it's been more than 24 hours since the last sync
-	if user.LastSyncedAt.Before(time.Now().Add(-1 * time.Hour * 24)) {
+	// Only sync if it's been more than 24 hours since the last sync, users without a linked GitHub account have nothing to sync
+	if user.GithubID.Valid && user.LastSyncedAt.Before(time.Now().Add(-1*time.Hour*24)) {
 		err = s.syncService.SyncUser(ctx, &user)
 		if err != nil {
 			slog.Error(fmt.Sprintf("Failed to sync user: %s", err.Error()))
@@ -67,6 +76,8 @@ func (s *RpcServer) Sync(ctx context.Context, req *connect.Request[apiv1.SyncReq
 	releases, err := s.repository.GetReleasesForUserShortDescription(ctx, repository.GetReleasesForUserShortDescriptionParams{
 		UserID:       user.ID,
 		IsPrerelease: sql.NullBool{Bool: true, Valid: true},
+		Now:          sql.NullTime{Time: time.Now(), Valid: true},
+		UnreadOnly:   false,
 	})
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to retrieve releases"))
@@ -81,20 +92,36 @@ func (s *RpcServer) Sync(ctx context.Context, req *connect.Request[apiv1.SyncReq
 
 	res.Msg.RepositoryCount = int32(len(repositories))
 
+	advisories, err := advisoriesByRelease(ctx, s.repository, releases, func(release repository.GetReleasesForUserShortDescriptionRow) int32 { return release.ID })
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve advisories"))
+	}
+
 	for _, release := range releases {
 		res.Msg.Timeline = append(res.Msg.Timeline, &apiv1.TimelineEntry{
-			Id:             release.ID,
-			RepositoryId:   release.RepositoryID,
-			Name:           release.Name,
-			Url:            release.Url,
-			TagName:        release.TagName,
-			Description:    release.DescriptionShort,
-			Author:         release.Author.String,
-			IsPrerelease:   release.IsPrerelease,
-			ReleasedAt:     timestamppb.New(release.ReleasedAt),
-			RepositoryName: release.RepositoryName.String,
-			ImageUrl:       release.ImageUrl.String,
-			StarType:       apiv1.RepositoryStarType(release.RepositoryStarType),
+			Id:                release.ID,
+			RepositoryId:      release.RepositoryID,
+			Name:              release.Name,
+			Url:               release.Url,
+			TagName:           release.TagName,
+			Description:       release.DescriptionShort,
+			Author:            release.Author.String,
+			IsPrerelease:      release.IsPrerelease,
+			ReleasedAt:        timestamppb.New(release.ReleasedAt),
+			RepositoryName:    release.RepositoryName.String,
+			ImageUrl:          release.ImageUrl.String,
+			StarType:          apiv1.RepositoryStarType(release.RepositoryStarType),
+			FirstSeenAt:       optionalTimestamp(release.FirstSeenAt),
+			IsBackfill:        release.IsBackfill,
+			IsSecurity:        release.IsSecurity,
+			IsBreaking:        release.IsBreaking,
+			HasDeprecation:    release.HasDeprecation,
+			HasMigrationGuide: release.HasMigrationGuide,
+			VulnerabilityIds:  vulnerabilityIDs(release.VulnerabilityIds),
+			Advisories:        advisories[release.ID],
+			EditedAt:          optionalTimestamp(release.EditedAt),
+			RetractedAt:       optionalTimestamp(release.RetractedAt),
+			IsRead:            isReleaseRead(&release, &user),
 		})
 	}
 
@@ -126,30 +153,110 @@ func (s *RpcServer) GetRepositories(ctx context.Context, req *connect.Request[ap
 		optionalStarType = sql.NullInt16{Int16: int16(*req.Msg.StarType), Valid: true}
 	}
 
+	optionalNewSince := sql.NullTime{Valid: false}
+	if req.Msg.NewSince != nil {
+		optionalNewSince = sql.NullTime{Time: req.Msg.NewSince.AsTime(), Valid: true}
+	}
+
+	optionalGroupID := sql.NullInt32{Valid: false}
+	if req.Msg.GroupId != nil {
+		group, err := s.repository.GetRepositoryGroup(ctx, repository.GetRepositoryGroupParams{
+			ID:     *req.Msg.GroupId,
+			UserID: user.ID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("group not found"))
+		}
+
+		optionalGroupID = sql.NullInt32{Int32: group.ID, Valid: true}
+	}
+
+	optionalListID := sql.NullInt32{Valid: false}
+	if req.Msg.ListId != nil {
+		list, err := s.repository.GetStarList(ctx, repository.GetStarListParams{
+			ID:     *req.Msg.ListId,
+			UserID: user.ID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("list not found"))
+		}
+
+		optionalListID = sql.NullInt32{Int32: list.ID, Valid: true}
+	}
+
+	optionalSecurity := sql.NullBool{Valid: false}
+	if req.Msg.Security != nil {
+		optionalSecurity = sql.NullBool{Bool: *req.Msg.Security, Valid: true}
+	}
+
+	optionalBreaking := sql.NullBool{Valid: false}
+	if req.Msg.Breaking != nil {
+		optionalBreaking = sql.NullBool{Bool: *req.Msg.Breaking, Valid: true}
+	}
+
 	releases, err := s.repository.GetReleasesForUserShortDescription(ctx, repository.GetReleasesForUserShortDescriptionParams{
 		UserID:       user.ID,
 		IsPrerelease: optionalPrerelease,
 		StarType:     optionalStarType,
+		GroupID:      optionalGroupID,
+		ListID:       optionalListID,
+		IsSecurity:   optionalSecurity,
+		IsBreaking:   optionalBreaking,
+		Now:          sql.NullTime{Time: time.Now(), Valid: true},
+		UnreadOnly:   req.Msg.UnreadOnly,
+		NewSince:     optionalNewSince,
 	})
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to retrieve releases"))
 	}
 
+	unreadCount, err := s.repository.CountUnreadReleasesForUser(ctx, repository.CountUnreadReleasesForUserParams{
+		UserID:       user.ID,
+		IsPrerelease: optionalPrerelease,
+		StarType:     optionalStarType,
+		GroupID:      optionalGroupID,
+		ListID:       optionalListID,
+		IsSecurity:   optionalSecurity,
+		IsBreaking:   optionalBreaking,
+		Now:          sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to count unread releases"))
+	}
+
+	res.Msg.UnreadCount = int32(unreadCount)
+
+	advisories, err := advisoriesByRelease(ctx, s.repository, releases, func(release repository.GetReleasesForUserShortDescriptionRow) int32 { return release.ID })
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve advisories"))
+	}
+
 	for _, release := range releases {
 		res.Msg.Timeline = append(res.Msg.Timeline, &apiv1.TimelineEntry{
-			Id:             release.ID,
-			RepositoryId:   release.RepositoryID,
-			Name:           release.Name,
-			Url:            release.Url,
-			TagName:        release.TagName,
-			Description:    release.DescriptionShort,
-			Author:         release.Author.String,
-			IsPrerelease:   release.IsPrerelease,
-			ReleasedAt:     timestamppb.New(release.ReleasedAt),
-			RepositoryName: release.RepositoryName.String,
-			RepositoryUrl:  release.RepositoryUrl.String,
-			ImageUrl:       release.ImageUrl.String,
-			StarType:       apiv1.RepositoryStarType(release.RepositoryStarType),
+			Id:                release.ID,
+			RepositoryId:      release.RepositoryID,
+			Name:              release.Name,
+			Url:               release.Url,
+			TagName:           release.TagName,
+			Description:       release.DescriptionShort,
+			Author:            release.Author.String,
+			IsPrerelease:      release.IsPrerelease,
+			ReleasedAt:        timestamppb.New(release.ReleasedAt),
+			RepositoryName:    release.RepositoryName.String,
+			RepositoryUrl:     release.RepositoryUrl.String,
+			ImageUrl:          release.ImageUrl.String,
+			StarType:          apiv1.RepositoryStarType(release.RepositoryStarType),
+			FirstSeenAt:       optionalTimestamp(release.FirstSeenAt),
+			IsBackfill:        release.IsBackfill,
+			IsSecurity:        release.IsSecurity,
+			IsBreaking:        release.IsBreaking,
+			HasDeprecation:    release.HasDeprecation,
+			HasMigrationGuide: release.HasMigrationGuide,
+			VulnerabilityIds:  vulnerabilityIDs(release.VulnerabilityIds),
+			Advisories:        advisories[release.ID],
+			EditedAt:          optionalTimestamp(release.EditedAt),
+			RetractedAt:       optionalTimestamp(release.RetractedAt),
+			IsRead:            isReleaseRead(&release, &user),
 		})
 	}
 
@@ -194,21 +301,80 @@ func (s *RpcServer) RefreshToken(ctx context.Context, req *connect.Request[apiv1
 
 	refreshToken := cookies[0].Value
 
-	userID, err := validateRefreshTokenClaims(refreshToken, []byte(s.config.JWTSecret))
+	userID, sessionID, refreshTokenID, err := validateRefreshTokenClaims(refreshToken, s.signingKeys)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("invalid refresh token"))
 	}
 
-	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	session, err := s.repository.GetActiveSession(ctx, repository.GetActiveSessionParams{
+		ID:  sessionID,
+		Now: time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("session expired or revoked"))
+	}
+
+	if int(session.UserID) != userID {
+		return nil, errors.New("invalid refresh token")
+	}
+
+	// Every refresh token is single use, another attempt with an old one means it was stolen, so the whole session is revoked
+	isConcurrent := session.RefreshTokenID != refreshTokenID && isConcurrentRefresh(&session, refreshTokenID, time.Now())
+	if session.RefreshTokenID != refreshTokenID && !isConcurrent {
+		slog.Warn(fmt.Sprintf("Refresh token reuse detected, revoking session %s of user %d", session.ID, session.UserID))
+
+		_, err = s.repository.RevokeSession(ctx, repository.RevokeSessionParams{
+			RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:        session.ID,
+			UserID:    session.UserID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to revoke session"))
+		}
+
+		return nil, errors.New("refresh token was already used")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, session.UserID)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to retrieve user"))
 	}
 
-	accessToken, refreshToken, accessTokenExpiresAt, refreshTokenExpiresAt, err := GenerateTokens(&user, []byte(s.config.JWTSecret))
+	newRefreshTokenID := uuid.NewString()
+	if !isConcurrent {
+		httpReq := http.Request{Header: req.Header()}
+		result, err := s.repository.RotateSessionRefreshToken(ctx, repository.RotateSessionRefreshTokenParams{
+			RefreshTokenID:        newRefreshTokenID,
+			RotatedAt:             sql.NullTime{Time: time.Now(), Valid: true},
+			UserAgent:             truncate(httpReq.UserAgent(), 512),
+			IpAddress:             clientIP(req.Header(), req.Peer().Addr),
+			ExpiresAt:             time.Now().Add(refreshTokenLifetime),
+			ID:                    session.ID,
+			CurrentRefreshTokenID: refreshTokenID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to rotate refresh token"))
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to rotate refresh token"))
+		}
+
+		// Another request rotated the token in the meantime
+		isConcurrent = rowsAffected == 0
+	}
+
+	accessToken, refreshToken, accessTokenExpiresAt, refreshTokenExpiresAt, err := GenerateTokens(&user, session.ID, newRefreshTokenID, s.signingKeys)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to generate tokens"))
 	}
 
+	// A concurrent refresh only gets a new access token, the request that rotated the session already handed out the new refresh token
+	if isConcurrent {
+		refreshToken = ""
+	}
+
 	res := connect.NewResponse(&apiv1.RefreshTokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -243,7 +409,9 @@ func (s *RpcServer) RefreshToken(ctx context.Context, req *connect.Request[apiv1
 	}
 
 	res.Header().Add("Set-Cookie", accessTokenCookie.String())
-	res.Header().Add("Set-Cookie", refreshTokenCookie.String())
+	if refreshToken != "" {
+		res.Header().Add("Set-Cookie", refreshTokenCookie.String())
+	}
 
 	return res, nil
 }
@@ -254,6 +422,20 @@ func (s *RpcServer) Logout(ctx context.Context, req *connect.Request[apiv1.Logou
 		return nil, errors.New("no user id in context")
 	}
 
+	sessionID, err := currentSessionID(req.Header(), s.signingKeys)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid access token"))
+	}
+
+	_, err = s.repository.RevokeSession(ctx, repository.RevokeSessionParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        sessionID,
+		UserID:    int32(userID.(int)),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke session"))
+	}
+
 	res := connect.NewResponse(&apiv1.LogoutResponse{})
 
 	accessTokenCookie := &http.Cookie{
@@ -302,6 +484,17 @@ func (s *RpcServer) GetMyUser(ctx context.Context, req *connect.Request[apiv1.Ge
 		PublicId:     user.PublicID,
 		Name:         user.Username,
 		IsOnboarded:  user.IsOnboarded,
+		GithubLinked: user.GithubID.Valid,
+	})
+
+	return res, nil
+}
+
+// GetLoginProviders tells the login page which logins are offered besides GitHub
+func (s *RpcServer) GetLoginProviders(ctx context.Context, req *connect.Request[apiv1.GetLoginProvidersRequest]) (*connect.Response[apiv1.GetLoginProvidersResponse], error) {
+	res := connect.NewResponse(&apiv1.GetLoginProvidersResponse{
+		OidcEnabled: s.config.OIDCIssuerURL != "",
+		OidcName:    s.config.OIDCName,
 	})
 
 	return res, nil
@@ -337,3 +530,1478 @@ func (s *RpcServer) ToggleUserOnboarded(ctx context.Context, req *connect.Reques
 
 	return connect.NewResponse(&apiv1.ToggleUserOnboardedResponse{}), nil
 }
+
+func (s *RpcServer) MarkReleaseRead(ctx context.Context, req *connect.Request[apiv1.MarkReleaseReadRequest]) (*connect.Response[apiv1.MarkReleaseReadResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	release, err := s.repository.GetReleaseByID(ctx, req.Msg.ReleaseId)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve release"))
+	}
+
+	// Only releases of repositories the user follows can be marked as read
+	_, err = s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: release.RepositoryID,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("release not found"))
+	}
+
+	err = s.repository.InsertReleaseRead(ctx, repository.InsertReleaseReadParams{
+		UserID:    int32(userID),
+		ReleaseID: release.ID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to mark release as read"))
+	}
+
+	return connect.NewResponse(&apiv1.MarkReleaseReadResponse{}), nil
+}
+
+func (s *RpcServer) MarkRepositoryRead(ctx context.Context, req *connect.Request[apiv1.MarkRepositoryReadRequest]) (*connect.Response[apiv1.MarkRepositoryReadResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	// Only repositories the user follows can be marked as read
+	_, err := s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("repository not found"))
+	}
+
+	err = s.repository.InsertRepositoryReleaseReads(ctx, repository.InsertRepositoryReleaseReadsParams{
+		CreatedAt:    time.Now(),
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to mark releases as read"))
+	}
+
+	return connect.NewResponse(&apiv1.MarkRepositoryReadResponse{}), nil
+}
+
+func (s *RpcServer) MarkAllRead(ctx context.Context, req *connect.Request[apiv1.MarkAllReadRequest]) (*connect.Response[apiv1.MarkAllReadResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	readAllAt := time.Now()
+
+	err := s.repository.UpdateUserReadAllAt(ctx, repository.UpdateUserReadAllAtParams{
+		ReadAllAt: sql.NullTime{Time: readAllAt, Valid: true},
+		ID:        int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to update user"))
+	}
+
+	// Individual read markers up to this point are covered by the high-water mark now
+	err = s.repository.DeleteReleaseReadsCreatedBefore(ctx, repository.DeleteReleaseReadsCreatedBeforeParams{
+		CreatedAt: readAllAt,
+		UserID:    int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to clean up read markers"))
+	}
+
+	return connect.NewResponse(&apiv1.MarkAllReadResponse{}), nil
+}
+
+// advisoriesByRelease loads the security advisories linked to the given releases, keyed by release ID
+func advisoriesByRelease[T any](ctx context.Context, queries *repository.Queries, releases []T, releaseID func(T) int32) (map[int32][]*apiv1.Advisory, error) {
+	releaseIDs := make([]int32, 0, len(releases))
+	for _, release := range releases {
+		releaseIDs = append(releaseIDs, releaseID(release))
+	}
+
+	advisories := make(map[int32][]*apiv1.Advisory)
+	if len(releaseIDs) == 0 {
+		return advisories, nil
+	}
+
+	releaseAdvisories, err := queries.GetAdvisoriesForReleases(ctx, releaseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, advisory := range releaseAdvisories {
+		advisories[advisory.ReleaseID] = append(advisories[advisory.ReleaseID], &apiv1.Advisory{
+			GhsaId:      advisory.GhsaID,
+			CveId:       advisory.CveID.String,
+			Severity:    advisory.Severity,
+			Summary:     advisory.Summary,
+			Url:         advisory.Url,
+			PublishedAt: timestamppb.New(advisory.PublishedAt),
+		})
+	}
+
+	return advisories, nil
+}
+
+// optionalTimestamp converts a nullable time, leaving the timestamp unset when it is null
+func optionalTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+
+	return timestamppb.New(t.Time)
+}
+
+// vulnerabilityIDs splits the comma separated identifiers stored on a release
+func vulnerabilityIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+
+	return strings.Split(ids, ",")
+}
+
+// isReleaseRead reports whether the user has already seen a release. Releases count as read when they
+// were explicitly marked, are covered by the user's "mark all as read" high-water mark, or were never new to the user
+// (backfilled history, releases that predate the user following the repository and ones stored before first seen times were tracked).
+func isReleaseRead(release *repository.GetReleasesForUserShortDescriptionRow, user *repository.User) bool {
+	if release.ReadAt.Valid || release.IsBackfill || !release.FirstSeenAt.Valid || release.FirstSeenAt.Time.Before(release.StarredAt) {
+		return true
+	}
+
+	return user.ReadAllAt.Valid && !release.FirstSeenAt.Time.After(user.ReadAllAt.Time)
+}
+
+func (s *RpcServer) GetBookmarks(ctx context.Context, req *connect.Request[apiv1.GetBookmarksRequest]) (*connect.Response[apiv1.GetBookmarksResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve user"))
+	}
+
+	// The private feed ID is created lazily, so users who never bookmark anything don't get one
+	if !user.PrivateFeedID.Valid {
+		user.PrivateFeedID = sql.NullString{String: uuid.NewString(), Valid: true}
+		err = s.repository.UpdateUserPrivateFeedID(ctx, repository.UpdateUserPrivateFeedIDParams{
+			PrivateFeedID: user.PrivateFeedID,
+			ID:            user.ID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to update user"))
+		}
+	}
+
+	bookmarks, err := s.repository.GetBookmarksForUser(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve bookmarks"))
+	}
+
+	res := connect.NewResponse(&apiv1.GetBookmarksResponse{
+		PrivateFeedId: user.PrivateFeedID.String,
+	})
+
+	for _, bookmark := range bookmarks {
+		res.Msg.Bookmarks = append(res.Msg.Bookmarks, &apiv1.Bookmark{
+			Release: &apiv1.TimelineEntry{
+				Id:                bookmark.ID,
+				RepositoryId:      bookmark.RepositoryID,
+				Name:              bookmark.Name,
+				Url:               bookmark.Url,
+				TagName:           bookmark.TagName,
+				Description:       bookmark.DescriptionShort,
+				Author:            bookmark.Author.String,
+				IsPrerelease:      bookmark.IsPrerelease,
+				ReleasedAt:        timestamppb.New(bookmark.ReleasedAt),
+				RepositoryName:    bookmark.RepositoryName,
+				RepositoryUrl:     bookmark.RepositoryUrl,
+				ImageUrl:          bookmark.ImageUrl,
+				FirstSeenAt:       optionalTimestamp(bookmark.FirstSeenAt),
+				IsBackfill:        bookmark.IsBackfill,
+				IsSecurity:        bookmark.IsSecurity,
+				IsBreaking:        bookmark.IsBreaking,
+				HasDeprecation:    bookmark.HasDeprecation,
+				HasMigrationGuide: bookmark.HasMigrationGuide,
+				VulnerabilityIds:  vulnerabilityIDs(bookmark.VulnerabilityIds),
+			},
+			Note:      bookmark.Note,
+			CreatedAt: timestamppb.New(bookmark.BookmarkedAt),
+			UpdatedAt: timestamppb.New(bookmark.BookmarkUpdatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) AddBookmark(ctx context.Context, req *connect.Request[apiv1.AddBookmarkRequest]) (*connect.Response[apiv1.AddBookmarkResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	release, err := s.repository.GetReleaseByID(ctx, req.Msg.ReleaseId)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve release"))
+	}
+
+	// Only releases of repositories the user follows can be bookmarked
+	_, err = s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: release.RepositoryID,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("release not found"))
+	}
+
+	err = s.repository.UpsertBookmark(ctx, repository.UpsertBookmarkParams{
+		UserID:    int32(userID),
+		ReleaseID: release.ID,
+		Note:      req.Msg.Note,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to save bookmark"))
+	}
+
+	return connect.NewResponse(&apiv1.AddBookmarkResponse{}), nil
+}
+
+func (s *RpcServer) RemoveBookmark(ctx context.Context, req *connect.Request[apiv1.RemoveBookmarkRequest]) (*connect.Response[apiv1.RemoveBookmarkResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	_, err := s.repository.DeleteBookmark(ctx, repository.DeleteBookmarkParams{
+		UserID:    int32(userID),
+		ReleaseID: req.Msg.ReleaseId,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to remove bookmark"))
+	}
+
+	return connect.NewResponse(&apiv1.RemoveBookmarkResponse{}), nil
+}
+
+func (s *RpcServer) MuteRepository(ctx context.Context, req *connect.Request[apiv1.MuteRepositoryRequest]) (*connect.Response[apiv1.MuteRepositoryResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	// MySQL only counts changed rows, so muting a muted repository updates nothing, whether the star exists is checked instead
+	_, err := s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("repository not found"))
+	}
+
+	_, err = s.repository.UpdateRepositoryStarMuted(ctx, repository.UpdateRepositoryStarMutedParams{
+		IsMuted:      req.Msg.Muted,
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to update repository"))
+	}
+
+	return connect.NewResponse(&apiv1.MuteRepositoryResponse{}), nil
+}
+
+func (s *RpcServer) SnoozeRepository(ctx context.Context, req *connect.Request[apiv1.SnoozeRepositoryRequest]) (*connect.Response[apiv1.SnoozeRepositoryResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	_, err := s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("repository not found"))
+	}
+
+	// Neither a date nor the next major version clears the snooze
+	snoozedUntil := sql.NullTime{Valid: false}
+	if req.Msg.Until != nil {
+		snoozedUntil = sql.NullTime{Time: req.Msg.Until.AsTime(), Valid: true}
+	}
+
+	snoozedUntilMajor := sql.NullInt32{Valid: false}
+	if req.Msg.UntilNextMajor {
+		releases, err := s.repository.GetReleases(ctx, req.Msg.RepositoryId)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to retrieve releases"))
+		}
+
+		for _, release := range releases {
+			if !release.MajorVersion.Valid {
+				continue
+			}
+
+			if !snoozedUntilMajor.Valid || release.MajorVersion.Int32 > snoozedUntilMajor.Int32 {
+				snoozedUntilMajor = release.MajorVersion
+			}
+		}
+
+		if !snoozedUntilMajor.Valid {
+			return nil, errors.New("repository has no versioned releases to snooze until the next major version")
+		}
+	}
+
+	_, err = s.repository.UpdateRepositoryStarSnooze(ctx, repository.UpdateRepositoryStarSnoozeParams{
+		SnoozedUntil:      snoozedUntil,
+		SnoozedUntilMajor: snoozedUntilMajor,
+		RepositoryID:      req.Msg.RepositoryId,
+		UserID:            int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to update repository"))
+	}
+
+	return connect.NewResponse(&apiv1.SnoozeRepositoryResponse{}), nil
+}
+
+func (s *RpcServer) GetMutedRepositories(ctx context.Context, req *connect.Request[apiv1.GetMutedRepositoriesRequest]) (*connect.Response[apiv1.GetMutedRepositoriesResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	repositories, err := s.repository.GetMutedRepositoriesForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve repositories"))
+	}
+
+	res := connect.NewResponse(&apiv1.GetMutedRepositoriesResponse{})
+
+	for _, repo := range repositories {
+		entry := &apiv1.Repository{
+			Id:       repo.ID,
+			Name:     repo.Name,
+			Url:      repo.Url,
+			ImageUrl: repo.ImageUrl,
+			IsMuted:  repo.IsMuted,
+		}
+
+		if repo.SnoozedUntil.Valid {
+			entry.SnoozedUntil = timestamppb.New(repo.SnoozedUntil.Time)
+		}
+
+		if repo.SnoozedUntilMajor.Valid {
+			entry.SnoozedUntilMajor = &repo.SnoozedUntilMajor.Int32
+		}
+
+		res.Msg.Repositories = append(res.Msg.Repositories, entry)
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) GetGroups(ctx context.Context, req *connect.Request[apiv1.GetGroupsRequest]) (*connect.Response[apiv1.GetGroupsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	groups, err := s.repository.GetRepositoryGroupsForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve groups"))
+	}
+
+	members, err := s.repository.GetRepositoryGroupMembersForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve group members"))
+	}
+
+	repositoryIDsByGroup := make(map[int32][]int32)
+	for _, member := range members {
+		repositoryIDsByGroup[member.GroupID] = append(repositoryIDsByGroup[member.GroupID], member.RepositoryID)
+	}
+
+	res := connect.NewResponse(&apiv1.GetGroupsResponse{})
+
+	for _, group := range groups {
+		res.Msg.Groups = append(res.Msg.Groups, &apiv1.RepositoryGroup{
+			Id:            group.ID,
+			Name:          group.Name,
+			RepositoryIds: repositoryIDsByGroup[group.ID],
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) CreateGroup(ctx context.Context, req *connect.Request[apiv1.CreateGroupRequest]) (*connect.Response[apiv1.CreateGroupResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, errors.New("group name must not be empty")
+	}
+
+	result, err := s.repository.CreateRepositoryGroup(ctx, repository.CreateRepositoryGroupParams{
+		UserID:    int32(userID),
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create group"))
+	}
+
+	groupID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&apiv1.CreateGroupResponse{
+		Group: &apiv1.RepositoryGroup{
+			Id:   int32(groupID),
+			Name: name,
+		},
+	}), nil
+}
+
+func (s *RpcServer) RenameGroup(ctx context.Context, req *connect.Request[apiv1.RenameGroupRequest]) (*connect.Response[apiv1.RenameGroupResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, errors.New("group name must not be empty")
+	}
+
+	_, err := s.repository.GetRepositoryGroup(ctx, repository.GetRepositoryGroupParams{
+		ID:     req.Msg.GroupId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("group not found"))
+	}
+
+	_, err = s.repository.UpdateRepositoryGroupName(ctx, repository.UpdateRepositoryGroupNameParams{
+		Name:      name,
+		UpdatedAt: time.Now(),
+		ID:        req.Msg.GroupId,
+		UserID:    int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to rename group"))
+	}
+
+	return connect.NewResponse(&apiv1.RenameGroupResponse{}), nil
+}
+
+func (s *RpcServer) DeleteGroup(ctx context.Context, req *connect.Request[apiv1.DeleteGroupRequest]) (*connect.Response[apiv1.DeleteGroupResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	result, err := s.repository.DeleteRepositoryGroup(ctx, repository.DeleteRepositoryGroupParams{
+		ID:     req.Msg.GroupId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to delete group"))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.New("group not found")
+	}
+
+	return connect.NewResponse(&apiv1.DeleteGroupResponse{}), nil
+}
+
+func (s *RpcServer) AddRepositoryToGroup(ctx context.Context, req *connect.Request[apiv1.AddRepositoryToGroupRequest]) (*connect.Response[apiv1.AddRepositoryToGroupResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	_, err := s.repository.GetRepositoryGroup(ctx, repository.GetRepositoryGroupParams{
+		ID:     req.Msg.GroupId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("group not found"))
+	}
+
+	_, err = s.repository.GetRepositoryStar(ctx, repository.GetRepositoryStarParams{
+		RepositoryID: req.Msg.RepositoryId,
+		UserID:       int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("repository not found"))
+	}
+
+	err = s.repository.InsertRepositoryGroupMember(ctx, repository.InsertRepositoryGroupMemberParams{
+		GroupID:      req.Msg.GroupId,
+		RepositoryID: req.Msg.RepositoryId,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to add repository to group"))
+	}
+
+	return connect.NewResponse(&apiv1.AddRepositoryToGroupResponse{}), nil
+}
+
+func (s *RpcServer) RemoveRepositoryFromGroup(ctx context.Context, req *connect.Request[apiv1.RemoveRepositoryFromGroupRequest]) (*connect.Response[apiv1.RemoveRepositoryFromGroupResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	_, err := s.repository.GetRepositoryGroup(ctx, repository.GetRepositoryGroupParams{
+		ID:     req.Msg.GroupId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("group not found"))
+	}
+
+	_, err = s.repository.DeleteRepositoryGroupMember(ctx, repository.DeleteRepositoryGroupMemberParams{
+		GroupID:      req.Msg.GroupId,
+		RepositoryID: req.Msg.RepositoryId,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to remove repository from group"))
+	}
+
+	return connect.NewResponse(&apiv1.RemoveRepositoryFromGroupResponse{}), nil
+}
+
+func (s *RpcServer) GetLists(ctx context.Context, req *connect.Request[apiv1.GetListsRequest]) (*connect.Response[apiv1.GetListsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	lists, err := s.repository.GetStarListsForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve lists"))
+	}
+
+	listRepositories, err := s.repository.GetStarListRepositoriesForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve list repositories"))
+	}
+
+	repositoryIDsByList := make(map[int32][]int32)
+	for _, listRepository := range listRepositories {
+		repositoryIDsByList[listRepository.ListID] = append(repositoryIDsByList[listRepository.ListID], listRepository.RepositoryID)
+	}
+
+	res := connect.NewResponse(&apiv1.GetListsResponse{})
+
+	for _, list := range lists {
+		res.Msg.Lists = append(res.Msg.Lists, &apiv1.StarList{
+			Id:            list.ID,
+			Name:          list.Name,
+			Slug:          list.Slug,
+			RepositoryIds: repositoryIDsByList[list.ID],
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) SearchReleases(ctx context.Context, req *connect.Request[apiv1.SearchReleasesRequest]) (*connect.Response[apiv1.SearchReleasesResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	terms := services.SearchTerms(req.Msg.Query)
+	if len(terms) == 0 {
+		return nil, errors.New("search query must not be empty")
+	}
+
+	optionalPrerelease := sql.NullBool{Bool: req.Msg.Prerelease, Valid: !req.Msg.Prerelease}
+
+	optionalRepositoryID := sql.NullInt32{Valid: false}
+	if req.Msg.RepositoryId != nil {
+		optionalRepositoryID = sql.NullInt32{Int32: *req.Msg.RepositoryId, Valid: true}
+	}
+
+	optionalReleasedAfter := sql.NullTime{Valid: false}
+	if req.Msg.ReleasedAfter != nil {
+		optionalReleasedAfter = sql.NullTime{Time: req.Msg.ReleasedAfter.AsTime(), Valid: true}
+	}
+
+	optionalReleasedBefore := sql.NullTime{Valid: false}
+	if req.Msg.ReleasedBefore != nil {
+		optionalReleasedBefore = sql.NullTime{Time: req.Msg.ReleasedBefore.AsTime(), Valid: true}
+	}
+
+	releases, err := s.repository.SearchReleasesForUser(ctx, repository.SearchReleasesForUserParams{
+		UserID:         int32(userID),
+		Query:          services.BooleanSearchQuery(terms),
+		IsPrerelease:   optionalPrerelease,
+		RepositoryID:   optionalRepositoryID,
+		ReleasedAfter:  optionalReleasedAfter,
+		ReleasedBefore: optionalReleasedBefore,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to search releases"))
+	}
+
+	res := connect.NewResponse(&apiv1.SearchReleasesResponse{})
+
+	for _, release := range releases {
+		res.Msg.Results = append(res.Msg.Results, &apiv1.SearchResult{
+			Release: &apiv1.TimelineEntry{
+				Id:                release.ID,
+				RepositoryId:      release.RepositoryID,
+				Name:              release.Name,
+				Url:               release.Url,
+				TagName:           release.TagName,
+				Description:       release.DescriptionShort,
+				Author:            release.Author.String,
+				IsPrerelease:      release.IsPrerelease,
+				ReleasedAt:        timestamppb.New(release.ReleasedAt),
+				RepositoryName:    release.RepositoryName,
+				RepositoryUrl:     release.RepositoryUrl,
+				ImageUrl:          release.ImageUrl,
+				StarType:          apiv1.RepositoryStarType(release.RepositoryStarType),
+				FirstSeenAt:       optionalTimestamp(release.FirstSeenAt),
+				IsBackfill:        release.IsBackfill,
+				IsSecurity:        release.IsSecurity,
+				IsBreaking:        release.IsBreaking,
+				HasDeprecation:    release.HasDeprecation,
+				HasMigrationGuide: release.HasMigrationGuide,
+				VulnerabilityIds:  vulnerabilityIDs(release.VulnerabilityIds),
+			},
+			Snippet: services.Snippet(release.DescriptionText, terms, 80),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) GetRelease(ctx context.Context, req *connect.Request[apiv1.GetReleaseRequest]) (*connect.Response[apiv1.GetReleaseResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	release, err := s.repository.GetReleaseForUser(ctx, repository.GetReleaseForUserParams{
+		ID:     req.Msg.ReleaseId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("release not found"))
+	}
+
+	advisories, err := advisoriesByRelease(ctx, s.repository, []int32{release.ID}, func(releaseID int32) int32 { return releaseID })
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve advisories"))
+	}
+
+	assets, err := s.repository.GetReleaseAssets(ctx, release.ID)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve assets"))
+	}
+
+	previous, err := s.repository.GetPreviousRelease(ctx, repository.GetPreviousReleaseParams{
+		RepositoryID: release.RepositoryID,
+		ReleasedAt:   release.ReleasedAt,
+		ID:           release.ID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Join(err, errors.New("failed to retrieve previous release"))
+	}
+	hasPrevious := err == nil
+
+	next, err := s.repository.GetNextRelease(ctx, repository.GetNextReleaseParams{
+		RepositoryID: release.RepositoryID,
+		ReleasedAt:   release.ReleasedAt,
+		ID:           release.ID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Join(err, errors.New("failed to retrieve next release"))
+	}
+	hasNext := err == nil
+
+	res := connect.NewResponse(&apiv1.GetReleaseResponse{
+		DescriptionHtml: release.Description,
+		Release: &apiv1.TimelineEntry{
+			Id:                release.ID,
+			RepositoryId:      release.RepositoryID,
+			Name:              release.Name,
+			Url:               release.Url,
+			TagName:           release.TagName,
+			Description:       release.DescriptionShort,
+			Author:            release.Author.String,
+			IsPrerelease:      release.IsPrerelease,
+			ReleasedAt:        timestamppb.New(release.ReleasedAt),
+			RepositoryName:    release.RepositoryName,
+			RepositoryUrl:     release.RepositoryUrl,
+			ImageUrl:          release.ImageUrl,
+			StarType:          apiv1.RepositoryStarType(release.RepositoryStarType),
+			FirstSeenAt:       optionalTimestamp(release.FirstSeenAt),
+			IsBackfill:        release.IsBackfill,
+			IsSecurity:        release.IsSecurity,
+			IsBreaking:        release.IsBreaking,
+			HasDeprecation:    release.HasDeprecation,
+			HasMigrationGuide: release.HasMigrationGuide,
+			VulnerabilityIds:  vulnerabilityIDs(release.VulnerabilityIds),
+			Advisories:        advisories[release.ID],
+			EditedAt:          optionalTimestamp(release.EditedAt),
+			RetractedAt:       optionalTimestamp(release.RetractedAt),
+		},
+	})
+
+	if hasPrevious {
+		res.Msg.Previous = neighborTimelineEntry(&previous, &release)
+		res.Msg.CompareUrl = fmt.Sprintf("%s/compare/%s...%s", release.RepositoryUrl, url.PathEscape(previous.TagName), url.PathEscape(release.TagName))
+	}
+
+	if hasNext {
+		res.Msg.Next = neighborTimelineEntry(&next, &release)
+	}
+
+	edits, err := s.repository.GetReleaseEdits(ctx, release.ID)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve release edits"))
+	}
+
+	for _, edit := range edits {
+		res.Msg.Edits = append(res.Msg.Edits, &apiv1.ReleaseEdit{
+			Id:           edit.ID,
+			Name:         edit.Name,
+			TagName:      edit.TagName,
+			IsPrerelease: edit.IsPrerelease,
+			EditedAt:     timestamppb.New(edit.EditedAt),
+		})
+	}
+
+	for _, asset := range assets {
+		res.Msg.Assets = append(res.Msg.Assets, &apiv1.ReleaseAsset{
+			Id:            asset.ID,
+			Name:          asset.Name,
+			ContentType:   asset.ContentType,
+			Size:          asset.Size,
+			DownloadCount: asset.DownloadCount,
+			Url:           asset.Url,
+		})
+	}
+
+	return res, nil
+}
+
+// neighborTimelineEntry describes a release of the same repository as release, for linking between releases
+func neighborTimelineEntry(neighbor *repository.Release, release *repository.GetReleaseForUserRow) *apiv1.TimelineEntry {
+	return &apiv1.TimelineEntry{
+		Id:             neighbor.ID,
+		RepositoryId:   neighbor.RepositoryID,
+		Name:           neighbor.Name,
+		Url:            neighbor.Url,
+		TagName:        neighbor.TagName,
+		Description:    neighbor.DescriptionShort,
+		Author:         neighbor.Author.String,
+		IsPrerelease:   neighbor.IsPrerelease,
+		ReleasedAt:     timestamppb.New(neighbor.ReleasedAt),
+		RepositoryName: release.RepositoryName,
+		RepositoryUrl:  release.RepositoryUrl,
+		ImageUrl:       release.ImageUrl,
+		StarType:       apiv1.RepositoryStarType(release.RepositoryStarType),
+		FirstSeenAt:    optionalTimestamp(neighbor.FirstSeenAt),
+		IsBackfill:     neighbor.IsBackfill,
+		IsSecurity:     neighbor.IsSecurity,
+		IsBreaking:     neighbor.IsBreaking,
+	}
+}
+
+const (
+	// GitHub doesn't return more than 100 nodes per connection
+	maxReleaseFetchDepth = 100
+	maxReleaseRetention  = 1000
+)
+
+func (s *RpcServer) GetReleaseDiff(ctx context.Context, req *connect.Request[apiv1.GetReleaseDiffRequest]) (*connect.Response[apiv1.GetReleaseDiffResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	release, err := s.repository.GetReleaseForUser(ctx, repository.GetReleaseForUserParams{
+		ID:     req.Msg.ReleaseId,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("release not found"))
+	}
+
+	// Without an edit the latest one is compared, each edit holds the version before it was replaced
+	var edit repository.ReleaseEdit
+	if req.Msg.EditId != nil {
+		edit, err = s.repository.GetReleaseEdit(ctx, repository.GetReleaseEditParams{
+			ID:        *req.Msg.EditId,
+			ReleaseID: release.ID,
+		})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("release edit not found"))
+		}
+	} else {
+		edits, err := s.repository.GetReleaseEdits(ctx, release.ID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to retrieve release edits"))
+		}
+
+		if len(edits) == 0 {
+			return nil, errors.New("release has not been edited")
+		}
+
+		edit = edits[0]
+	}
+
+	// The version that replaced the edit is the next newer edit, or the current release if there is none
+	description := release.Description
+	newer, err := s.repository.GetNewerReleaseEdit(ctx, repository.GetNewerReleaseEditParams{
+		ReleaseID: release.ID,
+		EditedAt:  edit.EditedAt,
+		ID:        edit.ID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Join(err, errors.New("failed to retrieve newer release edit"))
+	}
+	if err == nil {
+		description = newer.Description
+	}
+
+	res := connect.NewResponse(&apiv1.GetReleaseDiffResponse{
+		EditedAt:                timestamppb.New(edit.EditedAt),
+		PreviousDescriptionHtml: edit.Description,
+		DescriptionHtml:         description,
+	})
+
+	// Edits recorded by the sync carry their diff, older ones are diffed here. Notes too large to diff come without lines,
+	// both versions are in the response anyway.
+	lines, err := services.DecodeReleaseNotesDiff(edit.Diff)
+	if edit.Diff == nil || err != nil {
+		lines, err = services.DiffReleaseNotes(edit.Description, description)
+		if err != nil && !errors.Is(err, diff.ErrTooLarge) {
+			return nil, errors.Join(err, errors.New("failed to diff release notes"))
+		}
+	}
+
+	for _, line := range lines {
+		res.Msg.Lines = append(res.Msg.Lines, &apiv1.DiffLine{
+			Operation: apiv1.DiffOperation(line.Operation),
+			Text:      line.Text,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) GetReleaseHistorySettings(ctx context.Context, req *connect.Request[apiv1.GetReleaseHistorySettingsRequest]) (*connect.Response[apiv1.GetReleaseHistorySettingsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve user"))
+	}
+
+	return connect.NewResponse(&apiv1.GetReleaseHistorySettingsResponse{
+		Settings: s.releaseHistorySettings(user.ReleaseFetchDepth, user.ReleaseRetention),
+	}), nil
+}
+
+func (s *RpcServer) UpdateReleaseHistorySettings(ctx context.Context, req *connect.Request[apiv1.UpdateReleaseHistorySettingsRequest]) (*connect.Response[apiv1.UpdateReleaseHistorySettingsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	// Unset values fall back to the server defaults
+	releaseFetchDepth := sql.NullInt32{Valid: false}
+	if req.Msg.ReleaseFetchDepth != nil {
+		if *req.Msg.ReleaseFetchDepth < 1 || *req.Msg.ReleaseFetchDepth > maxReleaseFetchDepth {
+			return nil, fmt.Errorf("release fetch depth must be between 1 and %d", maxReleaseFetchDepth)
+		}
+
+		releaseFetchDepth = sql.NullInt32{Int32: *req.Msg.ReleaseFetchDepth, Valid: true}
+	}
+
+	releaseRetention := sql.NullInt32{Valid: false}
+	if req.Msg.ReleaseRetention != nil {
+		if *req.Msg.ReleaseRetention < 1 || *req.Msg.ReleaseRetention > maxReleaseRetention {
+			return nil, fmt.Errorf("release retention must be between 1 and %d", maxReleaseRetention)
+		}
+
+		releaseRetention = sql.NullInt32{Int32: *req.Msg.ReleaseRetention, Valid: true}
+	}
+
+	err := s.repository.UpdateUserReleaseHistory(ctx, repository.UpdateUserReleaseHistoryParams{
+		ReleaseFetchDepth: releaseFetchDepth,
+		ReleaseRetention:  releaseRetention,
+		ID:                int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to update user"))
+	}
+
+	return connect.NewResponse(&apiv1.UpdateReleaseHistorySettingsResponse{
+		Settings: s.releaseHistorySettings(releaseFetchDepth, releaseRetention),
+	}), nil
+}
+
+func (s *RpcServer) releaseHistorySettings(releaseFetchDepth sql.NullInt32, releaseRetention sql.NullInt32) *apiv1.ReleaseHistorySettings {
+	settings := &apiv1.ReleaseHistorySettings{
+		DefaultReleaseFetchDepth: int32(s.config.ReleaseFetchDepth),
+		DefaultReleaseRetention:  int32(s.config.ReleaseRetention),
+	}
+
+	if releaseFetchDepth.Valid {
+		settings.ReleaseFetchDepth = &releaseFetchDepth.Int32
+	}
+
+	if releaseRetention.Valid {
+		settings.ReleaseRetention = &releaseRetention.Int32
+	}
+
+	return settings
+}
+
+func (s *RpcServer) GetPrivateRepositorySettings(ctx context.Context, req *connect.Request[apiv1.GetPrivateRepositorySettingsRequest]) (*connect.Response[apiv1.GetPrivateRepositorySettingsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve user"))
+	}
+
+	return connect.NewResponse(&apiv1.GetPrivateRepositorySettingsResponse{
+		Settings: privateRepositorySettings(&user),
+	}), nil
+}
+
+func (s *RpcServer) UpdatePrivateRepositorySettings(ctx context.Context, req *connect.Request[apiv1.UpdatePrivateRepositorySettingsRequest]) (*connect.Response[apiv1.UpdatePrivateRepositorySettingsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve user"))
+	}
+
+	// Enabling needs a token that can read private repositories, which only a new login can grant
+	if req.Msg.SyncPrivate && !privateRepositorySettings(&user).HasPrivateScope {
+		return nil, errors.New("log in again with private repository access to sync private repositories")
+	}
+
+	err = s.repository.UpdateUserSyncPrivate(ctx, repository.UpdateUserSyncPrivateParams{
+		SyncPrivate: req.Msg.SyncPrivate,
+		ID:          user.ID,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to update user"))
+	}
+	user.SyncPrivate = req.Msg.SyncPrivate
+
+	// Private repositories disappear right away instead of with the next sync
+	if !req.Msg.SyncPrivate {
+		_, err = s.repository.DeletePrivateRepositoryStarsForUser(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to remove private repositories"))
+		}
+	}
+
+	return connect.NewResponse(&apiv1.UpdatePrivateRepositorySettingsResponse{
+		Settings: privateRepositorySettings(&user),
+	}), nil
+}
+
+// privateRepositorySettings describes whether a user syncs private repositories and whether their token allows it
+func privateRepositorySettings(user *repository.User) *apiv1.PrivateRepositorySettings {
+	return &apiv1.PrivateRepositorySettings{
+		SyncPrivate:     user.SyncPrivate,
+		HasPrivateScope: slices.Contains(strings.Split(user.GithubScopes, ","), github.PrivateRepositoryScope),
+	}
+}
+
+func (s *RpcServer) GetSessions(ctx context.Context, req *connect.Request[apiv1.GetSessionsRequest]) (*connect.Response[apiv1.GetSessionsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	currentSession, err := currentSessionID(req.Header(), s.signingKeys)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid access token"))
+	}
+
+	sessions, err := s.repository.GetActiveSessionsForUser(ctx, repository.GetActiveSessionsForUserParams{
+		UserID: int32(userID),
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve sessions"))
+	}
+
+	res := connect.NewResponse(&apiv1.GetSessionsResponse{})
+	for _, session := range sessions {
+		res.Msg.Sessions = append(res.Msg.Sessions, &apiv1.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IpAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			IsCurrent:  session.ID == currentSession,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) RevokeSession(ctx context.Context, req *connect.Request[apiv1.RevokeSessionRequest]) (*connect.Response[apiv1.RevokeSessionResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	result, err := s.repository.RevokeSession(ctx, repository.RevokeSessionParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        req.Msg.SessionId,
+		UserID:    int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke session"))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke session"))
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.New("session not found")
+	}
+
+	return connect.NewResponse(&apiv1.RevokeSessionResponse{}), nil
+}
+
+func (s *RpcServer) RevokeAllSessions(ctx context.Context, req *connect.Request[apiv1.RevokeAllSessionsRequest]) (*connect.Response[apiv1.RevokeAllSessionsResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	// Session IDs are never empty, so without keeping the current one every session is revoked
+	exceptID := ""
+	if req.Msg.KeepCurrent {
+		currentSession, err := currentSessionID(req.Header(), s.signingKeys)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("invalid access token"))
+		}
+
+		exceptID = currentSession
+	}
+
+	_, err := s.repository.RevokeSessionsForUser(ctx, repository.RevokeSessionsForUserParams{
+		RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		UserID:    int32(userID),
+		ExceptID:  exceptID,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke sessions"))
+	}
+
+	return connect.NewResponse(&apiv1.RevokeAllSessionsResponse{}), nil
+}
+
+func (s *RpcServer) CreateApiToken(ctx context.Context, req *connect.Request[apiv1.CreateApiTokenRequest]) (*connect.Response[apiv1.CreateApiTokenResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" || len(name) > 255 {
+		return nil, errors.New("name must be between 1 and 255 characters")
+	}
+
+	if len(req.Msg.Scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+
+	scopes := []string{}
+	for _, scope := range req.Msg.Scopes {
+		switch scope {
+		case apiv1.ApiTokenScope_READ:
+			scopes = append(scopes, ApiTokenScopeRead)
+		case apiv1.ApiTokenScope_WRITE:
+			scopes = append(scopes, ApiTokenScopeWrite)
+		default:
+			return nil, fmt.Errorf("unknown scope %d", scope)
+		}
+	}
+	slices.Sort(scopes)
+	scopes = slices.Compact(scopes)
+
+	expiresAt := sql.NullTime{Valid: false}
+	if req.Msg.ExpiresAt != nil {
+		if req.Msg.ExpiresAt.AsTime().Before(time.Now()) {
+			return nil, errors.New("expiry must be in the future")
+		}
+
+		expiresAt = sql.NullTime{Time: req.Msg.ExpiresAt.AsTime(), Valid: true}
+	}
+
+	token, hash, prefix, err := generateApiToken()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repository.CreateApiToken(ctx, repository.CreateApiTokenParams{
+		UserID:      int32(userID),
+		Name:        name,
+		TokenHash:   hash,
+		TokenPrefix: prefix,
+		Scopes:      strings.Join(scopes, ","),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create api token"))
+	}
+
+	apiTokenID, err := result.LastInsertId()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to create api token"))
+	}
+
+	// The token itself is only ever shown once, afterwards just its hash is known
+	return connect.NewResponse(&apiv1.CreateApiTokenResponse{
+		Token: token,
+		ApiToken: apiTokenMessage(&repository.ApiToken{
+			ID:          int32(apiTokenID),
+			Name:        name,
+			TokenPrefix: prefix,
+			Scopes:      strings.Join(scopes, ","),
+			CreatedAt:   time.Now(),
+			ExpiresAt:   expiresAt,
+		}),
+	}), nil
+}
+
+func (s *RpcServer) GetApiTokens(ctx context.Context, req *connect.Request[apiv1.GetApiTokensRequest]) (*connect.Response[apiv1.GetApiTokensResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	apiTokens, err := s.repository.GetApiTokensForUser(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve api tokens"))
+	}
+
+	res := connect.NewResponse(&apiv1.GetApiTokensResponse{})
+	for _, apiToken := range apiTokens {
+		res.Msg.ApiTokens = append(res.Msg.ApiTokens, apiTokenMessage(&apiToken))
+	}
+
+	return res, nil
+}
+
+func (s *RpcServer) RevokeApiToken(ctx context.Context, req *connect.Request[apiv1.RevokeApiTokenRequest]) (*connect.Response[apiv1.RevokeApiTokenResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	result, err := s.repository.DeleteApiToken(ctx, repository.DeleteApiTokenParams{
+		ID:     req.Msg.Id,
+		UserID: int32(userID),
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke api token"))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to revoke api token"))
+	}
+
+	if rowsAffected == 0 {
+		return nil, errors.New("api token not found")
+	}
+
+	return connect.NewResponse(&apiv1.RevokeApiTokenResponse{}), nil
+}
+
+// apiTokenMessage describes an API token without the token itself
+func apiTokenMessage(apiToken *repository.ApiToken) *apiv1.ApiToken {
+	message := &apiv1.ApiToken{
+		Id:         apiToken.ID,
+		Name:       apiToken.Name,
+		Prefix:     apiToken.TokenPrefix,
+		CreatedAt:  timestamppb.New(apiToken.CreatedAt),
+		LastUsedAt: optionalTimestamp(apiToken.LastUsedAt),
+		ExpiresAt:  optionalTimestamp(apiToken.ExpiresAt),
+	}
+
+	for _, scope := range strings.Split(apiToken.Scopes, ",") {
+		switch scope {
+		case ApiTokenScopeRead:
+			message.Scopes = append(message.Scopes, apiv1.ApiTokenScope_READ)
+		case ApiTokenScopeWrite:
+			message.Scopes = append(message.Scopes, apiv1.ApiTokenScope_WRITE)
+		}
+	}
+
+	return message
+}
+
+// DeleteMyAccount deletes the user with all of their data and revokes the GitHub grant, it can't be undone
+func (s *RpcServer) DeleteMyAccount(ctx context.Context, req *connect.Request[apiv1.DeleteMyAccountRequest]) (*connect.Response[apiv1.DeleteMyAccountResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	deletedRepositories, err := s.syncService.DeleteUser(ctx, int32(userID))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to delete user: %s", err.Error()))
+		return nil, errors.Join(err, errors.New("failed to delete account"))
+	}
+	slog.Info(fmt.Sprintf("Deleted user %d and %d repositories nobody else follows", userID, deletedRepositories))
+
+	res := connect.NewResponse(&apiv1.DeleteMyAccountResponse{})
+
+	// The sessions are gone with the user, the cookies go as well
+	for _, name := range []string{"access_token", "refresh_token"} {
+		cookie := &http.Cookie{
+			Name:     name,
+			Value:    "",
+			HttpOnly: true,
+			Secure:   true,
+			Domain:   s.baseURL.Hostname(),
+			SameSite: http.SameSiteNoneMode,
+			Path:     "/",
+			Expires:  time.Unix(0, 0),
+		}
+		res.Header().Add("Set-Cookie", cookie.String())
+	}
+
+	return res, nil
+}
+
+// ExportMyData returns everything stored about the user as a JSON archive
+func (s *RpcServer) ExportMyData(ctx context.Context, req *connect.Request[apiv1.ExportMyDataRequest]) (*connect.Response[apiv1.ExportMyDataResponse], error) {
+	userIDAny := authn.GetInfo(ctx)
+	if userIDAny == nil {
+		return nil, errors.New("no user id in context")
+	}
+
+	userID, ok := userIDAny.(int)
+	if !ok {
+		return nil, errors.New("invalid user id in context")
+	}
+
+	user, err := s.repository.GetUserByID(ctx, int32(userID))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to retrieve user"))
+	}
+
+	export, err := exportUserData(ctx, s.repository, &user)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to export data"))
+	}
+
+	archive, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to encode export"))
+	}
+
+	return connect.NewResponse(&apiv1.ExportMyDataResponse{
+		Archive:  archive,
+		FileName: fmt.Sprintf("releases.one-%s-%s.json", user.Username, export.ExportedAt.Format("2006-01-02")),
+	}), nil
+}
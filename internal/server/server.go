@@ -5,15 +5,20 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"io/fs"
 	"log"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
+	"slices"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
 
@@ -22,8 +27,11 @@ import (
 	"github.com/benjasper/releases.one/internal/config"
 	"github.com/benjasper/releases.one/internal/gen/api/v1/apiv1connect"
 	"github.com/benjasper/releases.one/internal/github"
+	"github.com/benjasper/releases.one/internal/oidc"
 	"github.com/benjasper/releases.one/internal/repository"
 	"github.com/benjasper/releases.one/internal/server/services"
+	"github.com/benjasper/releases.one/pkg/diff"
+	"github.com/benjasper/releases.one/pkg/jwtkeys"
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
 	"github.com/gorilla/feeds"
@@ -43,20 +51,27 @@ var (
 
 type Server struct {
 	config            *config.Config
+	db                *sql.DB
 	repository        *repository.Queries
 	syncService       *services.SyncService
 	githubOAuthConfig *oauth2.Config
+	oidcProvider      *oidc.Provider
 	baseURL           *url.URL
 	distFS            *fs.FS
 	indexHTML         []byte
+	signingKeys       *jwtkeys.Keyring
 }
 
-func NewServer(config *config.Config, repository *repository.Queries, githubOAuthConfig *oauth2.Config, baseURL *url.URL, distFS *fs.FS, indexHTML []byte) *Server {
+// NewServer creates the server, oidcProvider is nil when login with OpenID Connect isn't configured
+func NewServer(config *config.Config, db *sql.DB, repository *repository.Queries, githubOAuthConfig *oauth2.Config, oidcProvider *oidc.Provider, signingKeys *jwtkeys.Keyring, baseURL *url.URL, distFS *fs.FS, indexHTML []byte) *Server {
 	return &Server{
 		config:            config,
+		db:                db,
 		repository:        repository,
 		githubOAuthConfig: githubOAuthConfig,
-		syncService:       services.NewSyncService(repository, githubOAuthConfig),
+		oidcProvider:      oidcProvider,
+		signingKeys:       signingKeys,
+		syncService:       services.NewSyncService(config, repository, githubOAuthConfig),
 		baseURL:           baseURL,
 		distFS:            distFS,
 		indexHTML:         indexHTML,
@@ -65,27 +80,39 @@ func NewServer(config *config.Config, repository *repository.Queries, githubOAut
 
 // Start runs the server
 func (s *Server) Start() {
-	rpcServer := NewRpcServer(s.config, s.repository, s.syncService, s.baseURL)
+	rpcServer := NewRpcServer(s.config, s.repository, s.syncService, s.signingKeys, s.baseURL)
 	mux := http.NewServeMux()
 
 	middleware := authn.NewMiddleware(func(ctx context.Context, req *http.Request) (any, error) {
-		rawToken, _ := authn.BearerToken(req)
-
+		rawToken := accessTokenFromRequest(req)
 		if rawToken == "" {
-			cookies := req.CookiesNamed("access_token")
-			if len(cookies) == 0 {
-				return nil, errors.New("missing authorization")
+			return nil, errors.New("missing authorization")
+		}
+
+		// Personal API tokens are accepted alongside JWTs, limited to their scopes
+		if strings.HasPrefix(rawToken, apiTokenPrefix) {
+			userID, err := validateApiToken(ctx, s.repository, rawToken, req.URL.Path)
+			if err != nil {
+				slog.Info(fmt.Sprintf("Invalid api token: %s", err.Error()))
+				return nil, errors.New("invalid token")
 			}
 
-			rawToken = cookies[0].Value
+			return userID, nil
 		}
 
-		userID, err := validateAccessTokenClaims(rawToken, []byte(s.config.JWTSecret))
+		userID, sessionID, err := validateAccessTokenClaims(rawToken, s.signingKeys)
 		if err != nil {
 			slog.Error(fmt.Sprintf("Invalid access token: %s", err.Error()))
 			return nil, errors.New("invalid token")
 		}
 
+		// Access tokens die with their session, so revoking a session takes effect immediately
+		err = validateSession(ctx, s.repository, userID, sessionID)
+		if err != nil {
+			slog.Info(fmt.Sprintf("Invalid session: %s", err.Error()))
+			return nil, errors.New("invalid token")
+		}
+
 		return userID, nil
 	})
 
@@ -105,14 +132,18 @@ func (s *Server) Start() {
 	// most servers should mount both handlers.
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
+	mux.HandleFunc("/.well-known/jwks.json", s.GetJWKS)
 	mux.HandleFunc("/api/login/github", s.GetLoginWithGithub)
 	mux.HandleFunc("/api/github", s.GetLoginWithGithubCallback)
+	mux.HandleFunc("/api/login/oidc", s.GetLoginWithOIDC)
+	mux.HandleFunc("/api/oidc", s.GetLoginWithOIDCCallback)
 	mux.HandleFunc("/atom/{userID}", func(w http.ResponseWriter, r *http.Request) {
 		s.GetFeed(w, r, AtomFeedType)
 	})
 	mux.HandleFunc("/rss/{userID}", func(w http.ResponseWriter, r *http.Request) {
 		s.GetFeed(w, r, RssFeedType)
 	})
+	mux.HandleFunc("/atom/bookmarks/{feedID}", s.GetBookmarksFeed)
 
 	indexHtml, err := s.CreateViteTemplate()
 	if err != nil {
@@ -195,7 +226,8 @@ func (s *Server) ScheduleJobs() {
 			Limit:        100, // How many users to sync at a time
 		})
 		if err != nil {
-			log.Fatal(err)
+			slog.Error(fmt.Sprintf("Failed to get users in need of an update: %s", err.Error()))
+			return
 		}
 		slog.Info(fmt.Sprintf("Found %d user(s) in need of an update\n", len(users)))
 
@@ -212,19 +244,179 @@ func (s *Server) ScheduleJobs() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Releases are refreshed per repository on its own schedule, no matter how many users follow it
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Minute*5), gocron.NewTask(func(s *Server) {
+		ctx, cancel := context.WithTimeoutCause(context.Background(), time.Minute*5, errors.New("syncing repositories took too long"))
+		defer cancel()
+
+		refreshed, err := s.syncService.SyncRepositories(ctx, 500) // How many repositories to sync at a time
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to sync repositories: %s", err.Error()))
+			return
+		}
+		slog.Info(fmt.Sprintf("Synced %d repository(s)", refreshed))
+	}, s), gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Finishes key rotations, tokens are encrypted with the new primary key as soon as it is configured
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Hour), gocron.NewTask(func(s *Server) {
+		reencrypted, err := s.syncService.ReencryptTokens(context.Background())
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to re-encrypt tokens: %s", err.Error()))
+			return
+		}
+
+		if reencrypted > 0 {
+			slog.Info(fmt.Sprintf("Re-encrypted %d token(s) with key %s", reencrypted, repository.PrimaryTokenKeyID()))
+		}
+	}, s), gocron.WithStartAt(gocron.WithStartImmediately()))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Releases stored before first seen times were tracked get them once, before anything treats them as new
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Hour), gocron.NewTask(func(s *Server) {
+		backfilled, err := s.syncService.BackfillReleasesFirstSeen(context.Background())
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to backfill first seen times: %s", err.Error()))
+			return
+		}
+
+		if backfilled > 0 {
+			slog.Info(fmt.Sprintf("Backfilled first seen times of %d existing release(s)", backfilled))
+		}
+	}, s), gocron.WithStartAt(gocron.WithStartImmediately()), gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Releases stored before signals were detected get them once, afterwards this finds nothing left to do
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Hour), gocron.NewTask(func(s *Server) {
+		backfilled, err := s.syncService.BackfillReleaseSignals(context.Background())
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to backfill release signals: %s", err.Error()))
+			return
+		}
+
+		if backfilled > 0 {
+			slog.Info(fmt.Sprintf("Detected signals for %d existing release(s)", backfilled))
+		}
+	}, s), gocron.WithStartAt(gocron.WithStartImmediately()), gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Revoked and expired sessions are kept for a month, so they can still be looked into after an incident
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Hour*24), gocron.NewTask(func(s *Server) {
+		result, err := s.repository.DeleteSessionsEndedBefore(context.Background(), time.Now().Add(-time.Hour*24*30))
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to delete ended sessions: %s", err.Error()))
+			return
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to delete ended sessions: %s", err.Error()))
+			return
+		}
+		slog.Info(fmt.Sprintf("Deleted %d ended session(s)", rowsAffected))
+	}, s))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Repositories nobody follows anymore are kept for a grace period, afterwards they are deleted with their releases
+	_, err = scheduler.NewJob(gocron.DurationJob(time.Hour*24), gocron.NewTask(func(s *Server) {
+		result, err := s.syncService.CollectGarbage(context.Background(), s.config.GarbageCollectionGracePeriod, s.config.GarbageCollectionDryRun)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to collect garbage: %s", err.Error()))
+			return
+		}
+
+		// Dry runs don't change anything, their counts are what a real run would do
+		deletedRepositoriesKey, deletedReleasesKey := "deleted_repositories", "deleted_releases"
+		if result.DryRun {
+			deletedRepositoriesKey, deletedReleasesKey = "would_delete_repositories", "would_delete_releases"
+		}
+
+		slog.Info("Collected garbage",
+			"dry_run", result.DryRun,
+			"marked", result.Marked,
+			"unmarked", result.Unmarked,
+			deletedRepositoriesKey, result.DeletedRepositories,
+			deletedReleasesKey, result.DeletedReleases,
+		)
+	}, s))
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	scheduler.Start()
 }
 
 func (s *Server) GetLoginWithGithub(w http.ResponseWriter, r *http.Request) {
-	url := s.githubOAuthConfig.AuthCodeURL("state", oauth2.AccessTypeOffline)
+	// Deep links survive the login, as long as they stay on an allowed origin
+	returnTo := r.URL.Query().Get("return_to")
+	if returnTo != "" {
+		if _, err := s.resolveReturnTo(returnTo); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Return to is not allowed"))
+			return
+		}
+	}
+
+	// Users that logged in another way link their GitHub account to sync their stars, which needs them to be logged in already
+	linkUserID := 0
+	if link, _ := strconv.ParseBool(r.URL.Query().Get("link")); link {
+		userID, err := authenticatedUserID(r.Context(), s.repository, r, s.signingKeys)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Login required to link a GitHub account"))
+			return
+		}
+
+		linkUserID = userID
+	}
+
+	loginState, cookieValue, err := generateLoginState(returnTo, linkUserID, s.signingKeys)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to generate login state: %s", err.Error()))
+		http.Error(w, "Failed to generate login state", http.StatusInternalServerError)
+		return
+	}
+
+	s.setLoginStateCookie(w, "/api/github", cookieValue)
+
+	options := []oauth2.AuthCodeOption{oauth2.AccessTypeOffline, oauth2.S256ChallengeOption(loginState.CodeVerifier)}
+
+	// Syncing private repositories is opt-in, only then the broader scope is requested
+	if private, _ := strconv.ParseBool(r.URL.Query().Get("private")); private {
+		scopes := append(slices.Clone(s.githubOAuthConfig.Scopes), github.PrivateRepositoryScope)
+		options = append(options, oauth2.SetAuthURLParam("scope", strings.Join(scopes, " ")))
+	}
+
+	url := s.githubOAuthConfig.AuthCodeURL(loginState.State, options...)
 	http.Redirect(w, r, url, http.StatusFound)
 }
 
 func (s *Server) GetLoginWithGithubCallback(w http.ResponseWriter, r *http.Request) {
-	// TODO: Verify state
+	loginState, ok := s.consumeLoginState(w, r, "/api/github")
+	if !ok {
+		return
+	}
+
+	if errorCode := r.URL.Query().Get("error"); errorCode != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Login with GitHub failed: " + errorCode))
+		return
+	}
+
 	code := r.URL.Query().Get("code")
 
-	token, err := s.githubOAuthConfig.Exchange(context.Background(), code)
+	token, err := s.githubOAuthConfig.Exchange(context.Background(), code, oauth2.VerifierOption(loginState.CodeVerifier))
 	if err != nil {
 		http.Error(w, "Failed to exchange token: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -246,31 +438,46 @@ func (s *Server) GetLoginWithGithubCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	user, err := s.repository.GetUserByGitHubID(r.Context(), githubUser.ID)
-	if err != nil && errors.Is(err, sql.ErrNoRows) {
-		slog.Info("No user found, creating new user", "username", githubUser.Login)
-		_, err = s.repository.CreateUser(r.Context(), repository.CreateUserParams{
-			GithubID:     githubUser.ID,
-			Username:     githubUser.Login,
-			GithubToken:  repository.GitHubToken(*token),
-			LastSyncedAt: time.UnixMicro(0),
-			IsPublic:     false,
-			IsOnboarded:  false,
-			PublicID:     uuid.NewString(),
-		})
+	githubID := sql.NullInt64{Int64: int64(githubUser.ID), Valid: true}
+
+	var user repository.User
+	if loginState.LinkUserID != 0 {
+		user, err = s.linkGithubAccount(r.Context(), int32(loginState.LinkUserID), githubID)
 		if err != nil {
-			http.Error(w, "Failed to create user: "+err.Error(), http.StatusInternalServerError)
+			slog.Info(fmt.Sprintf("Failed to link GitHub account: %s", err.Error()))
+			http.Error(w, "Failed to link GitHub account: "+err.Error(), http.StatusConflict)
 			return
 		}
+	} else {
+		user, err = s.repository.GetUserByGitHubID(r.Context(), githubID)
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			slog.Info("No user found, creating new user", "username", githubUser.Login)
+			_, err = s.repository.CreateUser(r.Context(), repository.CreateUserParams{
+				GithubID:     githubID,
+				Username:     githubUser.Login,
+				GithubToken:  repository.GitHubToken(*token),
+				LastSyncedAt: time.UnixMicro(0),
+				IsPublic:     false,
+				IsOnboarded:  false,
+				PublicID:     uuid.NewString(),
+				GithubScopes: "",
+				SyncPrivate:  false,
+			})
+			// A concurrent first login of the same GitHub account created the user already
+			if err != nil && !repository.IsDuplicateEntry(err) {
+				http.Error(w, "Failed to create user: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
 
-		user, err = s.repository.GetUserByGitHubID(r.Context(), githubUser.ID)
-		if err != nil {
+			user, err = s.repository.GetUserByGitHubID(r.Context(), githubID)
+			if err != nil {
+				http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+		} else if err != nil {
 			http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
-	} else if err != nil {
-		http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
-		return
 	}
 
 	err = s.repository.UpdateUserToken(r.Context(), repository.UpdateUserTokenParams{
@@ -283,7 +490,116 @@ func (s *Server) GetLoginWithGithubCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	accessToken, refreshToken, accessTokenExpiresAt, refreshTokenExpiresAt, err := GenerateTokens(&user, []byte(s.config.JWTSecret))
+	// Granting the private repository scope is the opt-in, logging in without it later keeps the setting
+	scopes := github.GrantedScopes(token)
+	err = s.repository.UpdateUserGithubScopes(r.Context(), repository.UpdateUserGithubScopesParams{
+		GithubScopes: strings.Join(scopes, ","),
+		SyncPrivate:  user.SyncPrivate || slices.Contains(scopes, github.PrivateRepositoryScope),
+		ID:           user.ID,
+	})
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to update user: %s", err.Error()))
+		http.Error(w, "Failed to update user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// A linked account is added to the session the user already has
+	if loginState.LinkUserID != 0 {
+		s.redirectAfterLogin(w, r, loginState.ReturnTo, nil)
+		return
+	}
+
+	s.completeLogin(w, r, &user, loginState.ReturnTo)
+}
+
+// linkGithubAccount links a GitHub account to a user, as long as neither of them is linked to another one already.
+// The checks are made by the update itself, so concurrent links can't both succeed.
+func (s *Server) linkGithubAccount(ctx context.Context, userID int32, githubID sql.NullInt64) (repository.User, error) {
+	result, err := s.repository.LinkUserGithub(ctx, repository.LinkUserGithubParams{
+		GithubID: githubID,
+		ID:       userID,
+	})
+	if err != nil && repository.IsDuplicateEntry(err) {
+		return repository.User{}, errors.New("the GitHub account belongs to another user")
+	} else if err != nil {
+		return repository.User{}, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return repository.User{}, err
+	}
+
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return repository.User{}, err
+	}
+
+	// Nothing is updated when the user has a GitHub account already, which is fine if it is the same one
+	if rowsAffected == 0 && user.GithubID != githubID {
+		return repository.User{}, errors.New("another GitHub account is linked already")
+	}
+
+	return user, nil
+}
+
+// setLoginStateCookie stores a login attempt until the login provider redirects back to the callback at path
+func (s *Server) setLoginStateCookie(w http.ResponseWriter, path string, value string) {
+	// Lax, because the cookie has to come along on the redirect back from the login provider
+	http.SetCookie(w, &http.Cookie{
+		Name:     "login_state",
+		Value:    value,
+		HttpOnly: true,
+		Secure:   true,
+		Domain:   s.baseURL.Hostname(),
+		SameSite: http.SameSiteLaxMode,
+		Path:     path,
+		MaxAge:   int(loginStateLifetime.Seconds()),
+	})
+}
+
+// consumeLoginState validates the login attempt a callback belongs to and clears it, it writes the error response itself
+func (s *Server) consumeLoginState(w http.ResponseWriter, r *http.Request, path string) (*loginStateClaims, bool) {
+	stateCookie, err := r.Cookie("login_state")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Login expired, please try again"))
+		return nil, false
+	}
+
+	// The login state is single use
+	http.SetCookie(w, &http.Cookie{
+		Name:     "login_state",
+		Value:    "",
+		HttpOnly: true,
+		Secure:   true,
+		Domain:   s.baseURL.Hostname(),
+		SameSite: http.SameSiteLaxMode,
+		Path:     path,
+		MaxAge:   -1,
+	})
+
+	loginState, err := validateLoginState(stateCookie.Value, r.URL.Query().Get("state"), s.signingKeys)
+	if err != nil {
+		slog.Info(fmt.Sprintf("Invalid login state: %s", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid login state, please try again"))
+		return nil, false
+	}
+
+	return loginState, true
+}
+
+// completeLogin starts a session for a user that logged in and sends them on to where the login started
+func (s *Server) completeLogin(w http.ResponseWriter, r *http.Request, user *repository.User, returnTo string) {
+	sessionID, refreshTokenID, err := createSession(r.Context(), s.repository, user.ID, r.UserAgent(), clientIP(r.Header, r.RemoteAddr))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Failed to create session: %s", err.Error()))
+		http.Error(w, "Failed to create session", http.StatusInternalServerError)
+		return
+	}
+
+	accessToken, refreshToken, accessTokenExpiresAt, refreshTokenExpiresAt, err := GenerateTokens(user, sessionID, refreshTokenID, s.signingKeys)
 	if err != nil {
 		slog.Error(fmt.Sprintf("Failed to generate tokens: %s", err.Error()))
 		http.Error(w, "Failed to generate tokens", http.StatusInternalServerError)
@@ -312,6 +628,11 @@ func (s *Server) GetLoginWithGithubCallback(w http.ResponseWriter, r *http.Reque
 		Expires:  *refreshTokenExpiresAt,
 	})
 
+	s.redirectAfterLogin(w, r, returnTo, accessTokenExpiresAt)
+}
+
+// redirectAfterLogin redirects to the return to of a login or the login success redirect, letting the frontend know when the new access token expires
+func (s *Server) redirectAfterLogin(w http.ResponseWriter, r *http.Request, returnTo string, accessTokenExpiresAt *time.Time) {
 	loginSuccessRedirectURL := os.Getenv("LOGIN_SUCCESS_REDIRECT_URL")
 	if loginSuccessRedirectURL == "" {
 		loginSuccessRedirectURL = "/"
@@ -323,13 +644,47 @@ func (s *Server) GetLoginWithGithubCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	query := u.Query()
-	query.Add("access_token_expires_at", accessTokenExpiresAt.Format(time.RFC3339))
-	u.RawQuery = query.Encode()
+	// The return to was checked when the login started, but the allow-list could have changed since
+	if returnTo != "" {
+		resolved, err := s.resolveReturnTo(returnTo)
+		if err == nil {
+			u = resolved
+		}
+	}
+
+	if accessTokenExpiresAt != nil {
+		query := u.Query()
+		query.Add("access_token_expires_at", accessTokenExpiresAt.Format(time.RFC3339))
+		u.RawQuery = query.Encode()
+	}
 
 	http.Redirect(w, r, u.String(), http.StatusFound)
 }
 
+// GetJWKS publishes the public keys access tokens are signed with, so other services can verify them without a shared secret
+func (s *Server) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	body, err := json.Marshal(s.signingKeys.JWKS())
+	if err != nil {
+		http.Error(w, "Failed to encode keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Write(body)
+}
+
+// resolveReturnTo resolves a return to URL relative to the login success redirect, which is also where it may point by default
+func (s *Server) resolveReturnTo(returnTo string) (*url.URL, error) {
+	base, err := url.Parse(s.config.LoginSuccessRedirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedOrigins := append([]string{base.Scheme + "://" + base.Host}, s.config.LoginReturnToOrigins...)
+	return resolveReturnTo(returnTo, base, allowedOrigins)
+}
+
 func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedType) {
 	userID := r.PathValue("userID")
 	prereleaseString := r.URL.Query().Get("prerelease")
@@ -371,10 +726,79 @@ func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedTy
 		return
 	}
 
+	optionalGroupID := sql.NullInt32{Valid: false}
+	if groupName := r.URL.Query().Get("group"); groupName != "" {
+		group, err := s.repository.GetRepositoryGroupByName(r.Context(), repository.GetRepositoryGroupByNameParams{
+			UserID: user.ID,
+			Name:   groupName,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("Group not found"))
+			return
+		}
+
+		optionalGroupID = sql.NullInt32{Int32: group.ID, Valid: true}
+	}
+
+	optionalListID := sql.NullInt32{Valid: false}
+	if listSlug := r.URL.Query().Get("list"); listSlug != "" {
+		list, err := s.repository.GetStarListBySlug(r.Context(), repository.GetStarListBySlugParams{
+			UserID: user.ID,
+			Slug:   listSlug,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("List not found"))
+			return
+		}
+
+		optionalListID = sql.NullInt32{Int32: list.ID, Valid: true}
+	}
+
+	optionalSecurity := sql.NullBool{Valid: false}
+	if securityString := r.URL.Query().Get("security"); securityString != "" {
+		security, err := strconv.ParseBool(securityString)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Security must be true or false"))
+			return
+		}
+
+		optionalSecurity = sql.NullBool{Bool: security, Valid: true}
+	}
+
+	optionalBreaking := sql.NullBool{Valid: false}
+	if breakingString := r.URL.Query().Get("breaking"); breakingString != "" {
+		breaking, err := strconv.ParseBool(breakingString)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Breaking must be true or false"))
+			return
+		}
+
+		optionalBreaking = sql.NullBool{Bool: breaking, Valid: true}
+	}
+
+	updates := false
+	if updatesString := r.URL.Query().Get("updates"); updatesString != "" {
+		updates, err = strconv.ParseBool(updatesString)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Updates must be true or false"))
+			return
+		}
+	}
+
 	releases, err := s.repository.GetReleasesForUser(r.Context(), repository.GetReleasesForUserParams{
-		UserID: user.ID,
+		UserID:       user.ID,
 		IsPrerelease: optionalPrerelease,
 		StarType:     optionalStarType,
+		GroupID:      optionalGroupID,
+		ListID:       optionalListID,
+		IsSecurity:   optionalSecurity,
+		IsBreaking:   optionalBreaking,
+		Now:          sql.NullTime{Time: time.Now(), Valid: true},
 	})
 	if err != nil {
 		http.Error(w, "Failed to retrieve releases: "+err.Error(), http.StatusInternalServerError)
@@ -388,22 +812,124 @@ func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedTy
 		Updated:     user.LastSyncedAt,
 	}
 
+	advisories, err := advisoriesByRelease(r.Context(), s.repository, releases, func(release repository.GetReleasesForUserRow) int32 { return release.ID })
+	if err != nil {
+		http.Error(w, "Failed to retrieve advisories: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	releaseIDs := make([]int32, 0, len(releases))
 	for _, release := range releases {
+		releaseIDs = append(releaseIDs, release.ID)
+	}
+
+	releaseAssets, err := s.repository.GetAssetsForReleases(r.Context(), releaseIDs)
+	if err != nil {
+		http.Error(w, "Failed to retrieve assets: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	assets := make(map[int32][]repository.ReleaseAsset)
+	for _, asset := range releaseAssets {
+		assets[asset.ReleaseID] = append(assets[asset.ReleaseID], asset)
+	}
+
+	// The latest edit of each release, only needed when edits get their own entries
+	latestEdits := make(map[int32]repository.ReleaseEdit)
+	if updates {
+		releaseEdits, err := s.repository.GetReleaseEditsForReleases(r.Context(), releaseIDs)
+		if err != nil {
+			http.Error(w, "Failed to retrieve release edits: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, edit := range releaseEdits {
+			if _, ok := latestEdits[edit.ReleaseID]; !ok {
+				latestEdits[edit.ReleaseID] = edit
+			}
+		}
+	}
+
+	assetGlob := r.URL.Query().Get("asset")
+	if _, err := path.Match(assetGlob, ""); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Asset must be a valid glob pattern"))
+		return
+	}
+
+	// Enclosures of each item in feed.Items, in the same order
+	itemEnclosures := [][]*feeds.Enclosure{}
+
+	for _, release := range releases {
+		// Releases are dated by when they were first seen, so a release that was published late isn't buried under newer ones.
+		// Backfilled history keeps its release date, readers sort it where it belongs instead of announcing it as new.
+		created := release.ReleasedAt
+		if release.FirstSeenAt.Valid && !release.IsBackfill {
+			created = release.FirstSeenAt.Time
+		}
+
 		feedItem := &feeds.Item{
 			Id:          fmt.Sprintf("releases.one-%s-%s", release.RepositoryGithubID.String, release.GithubID),
 			Title:       fmt.Sprintf("%s: %s", release.RepositoryName.String, release.Name),
 			Link:        &feeds.Link{Href: release.Url},
 			Description: release.DescriptionShort,
 			Content:     release.Description,
-			Created:     release.ReleasedAt,
+			Created:     created,
+		}
+
+		// Atom readers show edited and retracted releases as updated entries
+		if release.EditedAt.Valid {
+			feedItem.Updated = release.EditedAt.Time
+		}
+
+		if release.RetractedAt.Valid {
+			feedItem.Title = fmt.Sprintf("[Retracted] %s", feedItem.Title)
+			feedItem.Updated = release.RetractedAt.Time
 		}
 
-		if release.ImageUrl.Valid {
-			feedItem.Enclosure = &feeds.Enclosure{
-				Url:  release.ImageUrl.String,
-				Type: "image/png",
-				// Length: strconv.Itoa(int(release.ImageSize.Int32)),
+		// Advisories go first, so they are visible without expanding the release notes
+		if len(advisories[release.ID]) > 0 {
+			advisoriesHTML := ""
+			for _, advisory := range advisories[release.ID] {
+				advisoriesHTML += fmt.Sprintf(
+					"<p><strong>Security advisory <a href=\"%s\">%s</a> (%s):</strong> %s</p>",
+					html.EscapeString(advisory.Url), html.EscapeString(advisory.GhsaId), html.EscapeString(advisory.Severity), html.EscapeString(advisory.Summary),
+				)
 			}
+
+			feedItem.Title = fmt.Sprintf("[Security] %s", feedItem.Title)
+			feedItem.Description = advisoriesHTML + feedItem.Description
+			feedItem.Content = advisoriesHTML + feedItem.Content
+		}
+
+		// Only assets matching the asset glob become enclosures, releases without any are left out
+		var enclosures []*feeds.Enclosure
+		for _, asset := range assets[release.ID] {
+			if assetGlob != "" {
+				if matched, _ := path.Match(assetGlob, asset.Name); !matched {
+					continue
+				}
+			}
+
+			contentType := asset.ContentType
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+
+			enclosures = append(enclosures, &feeds.Enclosure{
+				Url:    asset.Url,
+				Type:   contentType,
+				Length: strconv.FormatInt(asset.Size, 10),
+			})
+		}
+
+		if assetGlob != "" && len(enclosures) == 0 {
+			continue
+		}
+
+		// RSS only allows a single enclosure per item, Atom gets the rest as additional links below
+		if len(enclosures) > 0 {
+			feedItem.Enclosure = enclosures[0]
 		}
 
 		if release.Author.Valid {
@@ -411,6 +937,38 @@ func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedTy
 		}
 
 		feed.Add(feedItem)
+		itemEnclosures = append(itemEnclosures, enclosures)
+
+		// Edited release notes get an entry of their own, so readers that ignore updated entries still see them
+		if edit, ok := latestEdits[release.ID]; ok {
+			// The diff was stored when the edit was synced, edits without one were too large to diff or predate that
+			changesHTML := "<p>The release notes were changed.</p>"
+			if edit.Diff != nil {
+				lines, err := services.DecodeReleaseNotesDiff(edit.Diff)
+				if err != nil {
+					slog.Error(fmt.Sprintf("Failed to decode diff of release edit %d: %s", edit.ID, err.Error()))
+					continue
+				}
+
+				if !diff.HasChanges(lines) {
+					continue
+				}
+				changesHTML = releaseNotesDiffHTML(lines)
+			} else if edit.Description == release.Description {
+				continue
+			}
+
+			feed.Add(&feeds.Item{
+				Id:          fmt.Sprintf("%s-edit-%d", feedItem.Id, edit.ID),
+				Title:       fmt.Sprintf("[Updated] %s", feedItem.Title),
+				Link:        &feeds.Link{Href: release.Url},
+				Description: changesHTML,
+				Content:     changesHTML + "<hr>" + release.Description,
+				Author:      feedItem.Author,
+				Created:     edit.EditedAt,
+			})
+			itemEnclosures = append(itemEnclosures, nil)
+		}
 	}
 
 	var responseBody string
@@ -423,7 +981,14 @@ func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedTy
 
 		w.Header().Set("Content-Type", "application/rss+xml")
 	} else {
-		responseBody, err = feed.ToAtom()
+		atomFeed := (&feeds.Atom{Feed: feed}).AtomFeed()
+		for i, entry := range atomFeed.Entries {
+			for _, enclosure := range itemEnclosures[i][min(1, len(itemEnclosures[i])):] {
+				entry.Links = append(entry.Links, feeds.AtomLink{Href: enclosure.Url, Rel: "enclosure", Type: enclosure.Type, Length: enclosure.Length})
+			}
+		}
+
+		responseBody, err = feeds.ToXML(atomFeed)
 		if err != nil {
 			http.Error(w, "Failed to convert feed to atom: "+err.Error(), http.StatusInternalServerError)
 			return
@@ -434,3 +999,76 @@ func (s *Server) GetFeed(w http.ResponseWriter, r *http.Request, feedType FeedTy
 
 	w.Write([]byte(responseBody))
 }
+
+// releaseNotesDiffHTML renders the changed lines of a release notes diff, removed lines struck through
+func releaseNotesDiffHTML(lines []diff.Line) string {
+	builder := strings.Builder{}
+	for _, line := range lines {
+		switch line.Operation {
+		case diff.Insert:
+			builder.WriteString("<p><ins>" + html.EscapeString(line.Text) + "</ins></p>")
+		case diff.Delete:
+			builder.WriteString("<p><del>" + html.EscapeString(line.Text) + "</del></p>")
+		}
+	}
+
+	return builder.String()
+}
+
+// GetBookmarksFeed serves a user's bookmarked releases as an Atom feed. The feed is addressed by the user's private
+// feed ID instead of the public ID, so it works regardless of whether the user's public feed is enabled.
+func (s *Server) GetBookmarksFeed(w http.ResponseWriter, r *http.Request) {
+	feedID := r.PathValue("feedID")
+
+	user, err := s.repository.GetUserByPrivateFeedID(r.Context(), sql.NullString{String: feedID, Valid: true})
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("Feed not found"))
+		return
+	}
+
+	bookmarks, err := s.repository.GetBookmarksForUser(r.Context(), user.ID)
+	if err != nil {
+		http.Error(w, "Failed to retrieve bookmarks: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	feed := &feeds.Feed{
+		Title:       "GitHub Releases - Bookmarks",
+		Link:        &feeds.Link{Href: "https://releases.one"},
+		Description: "The releases you bookmarked on releases.one",
+		Updated:     user.LastSyncedAt,
+	}
+
+	for _, bookmark := range bookmarks {
+		content := bookmark.Description
+		if bookmark.Note != "" {
+			content = fmt.Sprintf("<p><strong>Note:</strong> %s</p>%s", html.EscapeString(bookmark.Note), content)
+		}
+
+		feedItem := &feeds.Item{
+			Id:          fmt.Sprintf("releases.one-bookmark-%s-%s", bookmark.RepositoryGithubID, bookmark.GithubID),
+			Title:       fmt.Sprintf("%s: %s", bookmark.RepositoryName, bookmark.Name),
+			Link:        &feeds.Link{Href: bookmark.Url},
+			Description: bookmark.DescriptionShort,
+			Content:     content,
+			Created:     bookmark.BookmarkedAt,
+			Updated:     bookmark.BookmarkUpdatedAt,
+		}
+
+		if bookmark.Author.Valid {
+			feedItem.Author = &feeds.Author{Name: bookmark.Author.String}
+		}
+
+		feed.Add(feedItem)
+	}
+
+	responseBody, err := feed.ToAtom()
+	if err != nil {
+		http.Error(w, "Failed to convert feed to atom: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml")
+	w.Write([]byte(responseBody))
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+// GarbageCollectionResult tells what a garbage collection run did. A dry run doesn't change anything, its counts are what
+// would have been marked, unmarked and deleted.
+type GarbageCollectionResult struct {
+	DryRun              bool
+	Marked              int64
+	Unmarked            int64
+	DeletedRepositories int64
+	DeletedReleases     int64
+}
+
+// garbageCollectorQueries are the queries a garbage collection run needs, tests replace them to not need a database
+type garbageCollectorQueries interface {
+	UnmarkFollowedRepositories(ctx context.Context) (sql.Result, error)
+	CountFollowedRepositoriesToUnmark(ctx context.Context) (int64, error)
+	GetRepositoriesOrphanedBefore(ctx context.Context, arg repository.GetRepositoriesOrphanedBeforeParams) ([]repository.GetRepositoriesOrphanedBeforeRow, error)
+	DeleteOrphanedRepository(ctx context.Context, arg repository.DeleteOrphanedRepositoryParams) (sql.Result, error)
+	MarkOrphanedRepositories(ctx context.Context, orphanedAt sql.NullTime) (sql.Result, error)
+	CountOrphanedRepositoriesToMark(ctx context.Context) (int64, error)
+}
+
+// CollectGarbage deletes repositories nobody stars, watches, groups, lists or bookmarks anymore, together with their releases.
+// Repositories are first marked as orphaned and only deleted once they stayed orphaned for the grace period, so a user who
+// unstars a repository by accident and stars it again doesn't lose anything. A dry run doesn't write anything, it only counts
+// what would be unmarked, deleted and marked.
+func (s *SyncService) CollectGarbage(ctx context.Context, gracePeriod time.Duration, dryRun bool) (*GarbageCollectionResult, error) {
+	return s.collectGarbage(ctx, s.repository, time.Now(), gracePeriod, dryRun)
+}
+
+func (s *SyncService) collectGarbage(ctx context.Context, queries garbageCollectorQueries, now time.Time, gracePeriod time.Duration, dryRun bool) (*GarbageCollectionResult, error) {
+	result := &GarbageCollectionResult{DryRun: dryRun}
+
+	// Repositories that are followed again are no longer orphaned
+	var err error
+	if dryRun {
+		result.Unmarked, err = queries.CountFollowedRepositoriesToUnmark(ctx)
+		if err != nil {
+			return result, err
+		}
+	} else {
+		var unmarked sql.Result
+		unmarked, err = queries.UnmarkFollowedRepositories(ctx)
+		if err != nil {
+			return result, err
+		}
+		result.Unmarked, err = unmarked.RowsAffected()
+		if err != nil {
+			return result, err
+		}
+	}
+
+	orphanedBefore := sql.NullTime{Time: now.Add(-gracePeriod), Valid: true}
+	afterID := int32(0)
+	for {
+		repositories, err := queries.GetRepositoriesOrphanedBefore(ctx, repository.GetRepositoriesOrphanedBeforeParams{
+			OrphanedBefore: orphanedBefore,
+			AfterID:        afterID,
+			Limit:          100,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		if len(repositories) == 0 {
+			break
+		}
+
+		for _, orphan := range repositories {
+			afterID = orphan.ID
+
+			if dryRun {
+				result.DeletedRepositories++
+				result.DeletedReleases += orphan.ReleaseCount
+				continue
+			}
+
+			deleted, err := s.deleteOrphanedRepository(ctx, queries, orphan.ID, orphan.Name, orphanedBefore)
+			if err != nil {
+				return result, err
+			}
+
+			if deleted {
+				result.DeletedRepositories++
+				result.DeletedReleases += orphan.ReleaseCount
+			}
+		}
+	}
+
+	// Marking starts the grace period, so a dry run only counts what it would mark
+	if dryRun {
+		result.Marked, err = queries.CountOrphanedRepositoriesToMark(ctx)
+		return result, err
+	}
+
+	marked, err := queries.MarkOrphanedRepositories(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return result, err
+	}
+	result.Marked, err = marked.RowsAffected()
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (s *SyncService) deleteOrphanedRepository(ctx context.Context, queries garbageCollectorQueries, repositoryID int32, name string, orphanedBefore sql.NullTime) (bool, error) {
+	// A sync could star the repository again right now, the query checks once more that nobody follows it while holding the lock
+	s.repositoryMutex.Lock(name)
+	defer s.repositoryMutex.Unlock(name)
+
+	result, err := queries.DeleteOrphanedRepository(ctx, repository.DeleteOrphanedRepositoryParams{
+		ID:             repositoryID,
+		OrphanedBefore: orphanedBefore,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/repository"
+	"github.com/benjasper/releases.one/pkg/keyedmutex"
+)
+
+type fakeRepository struct {
+	id         int32
+	name       string
+	followed   bool
+	orphanedAt sql.NullTime
+	releases   int64
+}
+
+// fakeGarbageCollectorQueries keeps repositories in memory and mirrors what the queries do in the database
+type fakeGarbageCollectorQueries struct {
+	repositories []*fakeRepository
+}
+
+type fakeResult int64
+
+func (r fakeResult) LastInsertId() (int64, error) { return 0, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+func (q *fakeGarbageCollectorQueries) UnmarkFollowedRepositories(ctx context.Context) (sql.Result, error) {
+	unmarked := 0
+	for _, repo := range q.repositories {
+		if repo.orphanedAt.Valid && repo.followed {
+			repo.orphanedAt = sql.NullTime{}
+			unmarked++
+		}
+	}
+	return fakeResult(unmarked), nil
+}
+
+func (q *fakeGarbageCollectorQueries) CountFollowedRepositoriesToUnmark(ctx context.Context) (int64, error) {
+	count := int64(0)
+	for _, repo := range q.repositories {
+		if repo.orphanedAt.Valid && repo.followed {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (q *fakeGarbageCollectorQueries) CountOrphanedRepositoriesToMark(ctx context.Context) (int64, error) {
+	count := int64(0)
+	for _, repo := range q.repositories {
+		if !repo.orphanedAt.Valid && !repo.followed {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (q *fakeGarbageCollectorQueries) GetRepositoriesOrphanedBefore(ctx context.Context, arg repository.GetRepositoriesOrphanedBeforeParams) ([]repository.GetRepositoriesOrphanedBeforeRow, error) {
+	var rows []repository.GetRepositoriesOrphanedBeforeRow
+	for _, repo := range q.repositories {
+		if repo.orphanedAt.Valid && repo.orphanedAt.Time.Before(arg.OrphanedBefore.Time) && !repo.followed && repo.id > arg.AfterID && len(rows) < int(arg.Limit) {
+			rows = append(rows, repository.GetRepositoriesOrphanedBeforeRow{ID: repo.id, Name: repo.name, ReleaseCount: repo.releases})
+		}
+	}
+	return rows, nil
+}
+
+func (q *fakeGarbageCollectorQueries) DeleteOrphanedRepository(ctx context.Context, arg repository.DeleteOrphanedRepositoryParams) (sql.Result, error) {
+	index := slices.IndexFunc(q.repositories, func(repo *fakeRepository) bool {
+		return repo.id == arg.ID && repo.orphanedAt.Valid && repo.orphanedAt.Time.Before(arg.OrphanedBefore.Time) && !repo.followed
+	})
+	if index < 0 {
+		return fakeResult(0), nil
+	}
+
+	q.repositories = slices.Delete(q.repositories, index, index+1)
+	return fakeResult(1), nil
+}
+
+func (q *fakeGarbageCollectorQueries) MarkOrphanedRepositories(ctx context.Context, orphanedAt sql.NullTime) (sql.Result, error) {
+	marked := 0
+	for _, repo := range q.repositories {
+		if !repo.orphanedAt.Valid && !repo.followed {
+			repo.orphanedAt = orphanedAt
+			marked++
+		}
+	}
+	return fakeResult(marked), nil
+}
+
+func (q *fakeGarbageCollectorQueries) repository(id int32) *fakeRepository {
+	index := slices.IndexFunc(q.repositories, func(repo *fakeRepository) bool { return repo.id == id })
+	if index < 0 {
+		return nil
+	}
+	return q.repositories[index]
+}
+
+func TestCollectGarbageGracePeriod(t *testing.T) {
+	s := &SyncService{repositoryMutex: keyedmutex.NewKeyedMutex()}
+	queries := &fakeGarbageCollectorQueries{repositories: []*fakeRepository{
+		{id: 1, name: "followed/repo", followed: true, releases: 5},
+		{id: 2, name: "unfollowed/repo", releases: 3},
+	}}
+	gracePeriod := 7 * 24 * time.Hour
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	result, err := s.collectGarbage(context.Background(), queries, now, gracePeriod, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Marked != 1 || result.DeletedRepositories != 0 {
+		t.Fatalf("first run = %+v, want the unfollowed repository marked and nothing deleted", result)
+	}
+
+	result, err = s.collectGarbage(context.Background(), queries, now.Add(gracePeriod-time.Hour), gracePeriod, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DeletedRepositories != 0 || queries.repository(2) == nil {
+		t.Fatalf("run within the grace period = %+v, want nothing deleted", result)
+	}
+
+	result, err = s.collectGarbage(context.Background(), queries, now.Add(gracePeriod+time.Hour), gracePeriod, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DeletedRepositories != 1 || result.DeletedReleases != 3 || queries.repository(2) != nil {
+		t.Fatalf("run after the grace period = %+v, want the unfollowed repository deleted", result)
+	}
+	if queries.repository(1) == nil {
+		t.Fatal("followed repository was deleted")
+	}
+}
+
+func TestCollectGarbageDryRun(t *testing.T) {
+	s := &SyncService{repositoryMutex: keyedmutex.NewKeyedMutex()}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	orphanedAt := sql.NullTime{Time: now.Add(-30 * 24 * time.Hour), Valid: true}
+	queries := &fakeGarbageCollectorQueries{repositories: []*fakeRepository{
+		{id: 1, name: "long/orphaned", orphanedAt: orphanedAt, releases: 4},
+		{id: 2, name: "just/unfollowed", releases: 2},
+		{id: 3, name: "starred/again", followed: true, orphanedAt: orphanedAt, releases: 1},
+	}}
+
+	result, err := s.collectGarbage(context.Background(), queries, now, 7*24*time.Hour, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.DryRun || result.DeletedRepositories != 1 || result.DeletedReleases != 4 || result.Marked != 1 || result.Unmarked != 1 {
+		t.Fatalf("dry run = %+v, want one repository counted as deleted, marked and unmarked each", result)
+	}
+
+	// Nothing is written, marking would start the grace period of the unfollowed repository
+	if queries.repository(1) == nil {
+		t.Fatal("dry run deleted a repository")
+	}
+	if queries.repository(2).orphanedAt.Valid {
+		t.Fatal("dry run marked a repository")
+	}
+	if queries.repository(3).orphanedAt != orphanedAt {
+		t.Fatal("dry run unmarked a repository")
+	}
+}
+
+func TestCollectGarbageUnmarksRefollowedRepositories(t *testing.T) {
+	s := &SyncService{repositoryMutex: keyedmutex.NewKeyedMutex()}
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	queries := &fakeGarbageCollectorQueries{repositories: []*fakeRepository{
+		{id: 1, name: "starred/again", followed: true, orphanedAt: sql.NullTime{Time: now.Add(-30 * 24 * time.Hour), Valid: true}, releases: 4},
+	}}
+
+	result, err := s.collectGarbage(context.Background(), queries, now, 7*24*time.Hour, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Unmarked != 1 || result.DeletedRepositories != 0 {
+		t.Fatalf("run = %+v, want the repository unmarked and not deleted", result)
+	}
+
+	if repo := queries.repository(1); repo == nil || repo.orphanedAt.Valid {
+		t.Fatal("repository followed again is still marked as orphaned")
+	}
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/benjasper/releases.one/pkg/diff"
+	nethtml "golang.org/x/net/html"
+)
+
+// blockElements are the tags that separate lines of text visually
+var blockElements = map[string]bool{
+	"br": true, "p": true, "div": true, "li": true, "ul": true, "ol": true, "tr": true, "td": true, "th": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "pre": true, "blockquote": true, "hr": true,
+}
+
+// headingElements are the tags markdown writes as "#" headings
+var headingElements = map[string]bool{"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true}
+
+// HTMLToText extracts the readable text from rendered release notes, so they can be indexed for full-text search
+func HTMLToText(source string) string {
+	return strings.Join(HTMLToLines(source), " ")
+}
+
+// HTMLToLines extracts the readable text from rendered release notes, one line per block element like paragraphs
+// and list items. Empty lines are dropped.
+func HTMLToLines(source string) []string {
+	return htmlToLines(source, false)
+}
+
+// HTMLToNotes turns rendered release notes back into plain notes, with headings marked like in markdown, for
+// releases whose original markdown wasn't stored
+func HTMLToNotes(source string) string {
+	return strings.Join(htmlToLines(source, true), "\n")
+}
+
+func htmlToLines(source string, markHeadings bool) []string {
+	tokenizer := nethtml.NewTokenizer(strings.NewReader(source))
+	builder := strings.Builder{}
+
+	for {
+		switch tokenType := tokenizer.Next(); tokenType {
+		case nethtml.ErrorToken:
+			lines := []string{}
+			for _, line := range strings.Split(builder.String(), "\n") {
+				if line = strings.Join(strings.Fields(line), " "); line != "" {
+					lines = append(lines, line)
+				}
+			}
+
+			return lines
+		case nethtml.TextToken:
+			// Newlines within text are only formatting, block elements decide where lines end
+			builder.WriteString(strings.ReplaceAll(string(tokenizer.Text()), "\n", " "))
+		case nethtml.StartTagToken, nethtml.EndTagToken, nethtml.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if blockElements[string(name)] {
+				builder.WriteString("\n")
+			}
+
+			if markHeadings && tokenType == nethtml.StartTagToken && headingElements[string(name)] {
+				builder.WriteString("# ")
+			}
+		}
+	}
+}
+
+// DiffReleaseNotes compares two versions of rendered release notes line by line, it returns diff.ErrTooLarge for notes
+// that are too large to be compared cheaply
+func DiffReleaseNotes(previous string, current string) ([]diff.Line, error) {
+	return diff.Lines(HTMLToLines(previous), HTMLToLines(current))
+}
+
+// EncodeReleaseNotesDiff diffs two versions of rendered release notes to be stored with the edit, so the diff is
+// computed once at sync time. Notes that are too large to diff are stored without a diff, which is nil.
+func EncodeReleaseNotesDiff(previous string, current string) (json.RawMessage, error) {
+	lines, err := DiffReleaseNotes(previous, current)
+	if errors.Is(err, diff.ErrTooLarge) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(lines)
+}
+
+// DecodeReleaseNotesDiff reads a diff stored by EncodeReleaseNotesDiff
+func DecodeReleaseNotesDiff(encoded json.RawMessage) ([]diff.Line, error) {
+	var lines []diff.Line
+	err := json.Unmarshal(encoded, &lines)
+	return lines, err
+}
+
+// minSearchTermLength mirrors MySQL's innodb_ft_min_token_size, shorter terms are not in the index
+const minSearchTermLength = 3
+
+// SearchTerms splits a user supplied query into the words that should be searched for
+func SearchTerms(query string) []string {
+	return strings.FieldsFunc(query, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '"'
+	})
+}
+
+// BooleanSearchQuery builds a MySQL boolean mode query requiring every term, each term is quoted
+// so characters like "-" in "CVE-2026-1234" aren't interpreted as operators
+func BooleanSearchQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		// Short terms can't be required, the index doesn't know about them
+		if len([]rune(term)) < minSearchTermLength {
+			parts = append(parts, `"`+term+`"`)
+			continue
+		}
+
+		parts = append(parts, `+"`+term+`"`)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Snippet returns an HTML escaped excerpt of text around the first matching term, with all matches wrapped in <mark>
+func Snippet(text string, terms []string, radius int) string {
+	lowerText := strings.ToLower(text)
+	// Lowercasing can change the byte length of some characters, offsets have to line up with text
+	if len(lowerText) != len(text) {
+		lowerText = text
+	}
+
+	// Matches are found in the lowercased text, so they end after the lowercased term, not the term itself
+	start, end := -1, 0
+	for _, term := range terms {
+		lowerTerm := strings.ToLower(term)
+		idx := strings.Index(lowerText, lowerTerm)
+		if idx >= 0 && (start < 0 || idx < start) {
+			start, end = idx, min(idx+len(lowerTerm), len(text))
+		}
+	}
+
+	if start < 0 {
+		start, end = 0, 0
+	}
+
+	from := max(start-radius, 0)
+	to := min(end+radius, len(text))
+
+	// Don't cut words or multi-byte characters in half
+	for from > 0 && !unicode.IsSpace(rune(text[from-1])) {
+		from--
+	}
+	for to < len(text) && !unicode.IsSpace(rune(text[to])) {
+		to++
+	}
+
+	excerpt := text[from:to]
+	lowerExcerpt := lowerText[from:to]
+
+	builder := strings.Builder{}
+	if from > 0 {
+		builder.WriteString("…")
+	}
+
+	position := 0
+	for position < len(excerpt) {
+		matchStart, matchEnd := -1, -1
+		for _, term := range terms {
+			if term == "" {
+				continue
+			}
+
+			lowerTerm := strings.ToLower(term)
+			idx := strings.Index(lowerExcerpt[position:], lowerTerm)
+			if idx >= 0 && (matchStart < 0 || position+idx < matchStart) {
+				matchStart = position + idx
+				matchEnd = min(matchStart+len(lowerTerm), len(excerpt))
+			}
+		}
+
+		if matchStart < 0 {
+			builder.WriteString(html.EscapeString(excerpt[position:]))
+			break
+		}
+
+		builder.WriteString(html.EscapeString(excerpt[position:matchStart]))
+		builder.WriteString("<mark>")
+		builder.WriteString(html.EscapeString(excerpt[matchStart:matchEnd]))
+		builder.WriteString("</mark>")
+		position = matchEnd
+	}
+
+	if to < len(text) {
+		builder.WriteString("…")
+	}
+
+	return builder.String()
+}
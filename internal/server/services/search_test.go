@@ -0,0 +1,115 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/benjasper/releases.one/internal/signals"
+	"github.com/benjasper/releases.one/pkg/diff"
+)
+
+func TestHTMLToText(t *testing.T) {
+	tests := []struct {
+		html string
+		text string
+	}{
+		{"<h2>Breaking</h2><ul><li>Dropped Node 18</li><li>Fixed <code>CVE-2026-1234</code></li></ul>", "Breaking Dropped Node 18 Fixed CVE-2026-1234"},
+		{"<p>Fish &amp; chips</p><p>are<br>great</p>", "Fish & chips are great"},
+		{"plain <strong>text</strong>", "plain text"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		if text := HTMLToText(test.html); text != test.text {
+			t.Errorf("HTMLToText(%q) = %q, want %q", test.html, text, test.text)
+		}
+	}
+}
+
+func TestHTMLToLines(t *testing.T) {
+	lines := HTMLToLines("<h2>Known issues</h2>\n<ul>\n<li>Crashes on\narm64</li>\n<li>Slow <em>startup</em></li>\n</ul>")
+	want := []string{"Known issues", "Crashes on arm64", "Slow startup"}
+
+	if !slices.Equal(lines, want) {
+		t.Errorf("HTMLToLines() = %q, want %q", lines, want)
+	}
+}
+
+func TestHTMLToNotes(t *testing.T) {
+	notes := HTMLToNotes("<h2>Breaking changes</h2>\n<ul>\n<li>feat(api)!: drop v1</li>\n</ul>\n<h3>Security</h3><p>Fixed a bug</p>")
+	want := "# Breaking changes\nfeat(api)!: drop v1\n# Security\nFixed a bug"
+
+	if notes != want {
+		t.Errorf("HTMLToNotes() = %q, want %q", notes, want)
+	}
+
+	releaseSignals := signals.Detect(HTMLToNotes("<h3>Security</h3><p>Fixed a bug</p><p>fix!: rename flag</p>"))
+	if !releaseSignals.IsSecurity || !releaseSignals.IsBreaking {
+		t.Errorf("signals.Detect() on rendered notes = %+v, want security and breaking", releaseSignals)
+	}
+}
+
+func TestBooleanSearchQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"CVE-2026-1234", `+"CVE-2026-1234"`},
+		{"dropped Node 18", `+"dropped" +"Node" "18"`},
+		{`"quoted"  term`, `+"quoted" +"term"`},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		if got := BooleanSearchQuery(SearchTerms(test.query)); got != test.want {
+			t.Errorf("BooleanSearchQuery(%q) = %q, want %q", test.query, got, test.want)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		text   string
+		terms  []string
+		radius int
+		want   string
+	}{
+		{"We dropped support for node 18 this release", []string{"Node"}, 100, "We dropped support for <mark>node</mark> 18 this release"},
+		{"one two three four five six seven", []string{"four"}, 4, "…three <mark>four</mark> five…"},
+		{"a <b> & node", []string{"node"}, 100, "a &lt;b&gt; &amp; <mark>node</mark>"},
+		{"no match here", []string{"missing"}, 5, "no match…"},
+		// The Kelvin sign lowercases to a one byte "k"
+		{"Fix K", []string{"\u212a"}, 100, "Fix <mark>K</mark>"},
+	}
+
+	for _, test := range tests {
+		if got := Snippet(test.text, test.terms, test.radius); got != test.want {
+			t.Errorf("Snippet(%q, %v, %d) = %q, want %q", test.text, test.terms, test.radius, got, test.want)
+		}
+	}
+}
+
+func TestEncodeReleaseNotesDiff(t *testing.T) {
+	encoded, err := EncodeReleaseNotesDiff("<p>Fixed a bug</p>", "<p>Fixed a bug</p><p>Known issue</p>")
+	if err != nil {
+		t.Fatalf("EncodeReleaseNotesDiff() returned error: %s", err)
+	}
+
+	lines, err := DecodeReleaseNotesDiff(encoded)
+	if err != nil {
+		t.Fatalf("DecodeReleaseNotesDiff() returned error: %s", err)
+	}
+
+	want := []diff.Line{{Operation: diff.Equal, Text: "Fixed a bug"}, {Operation: diff.Insert, Text: "Known issue"}}
+	if !slices.Equal(lines, want) {
+		t.Errorf("DecodeReleaseNotesDiff() = %v, want %v", lines, want)
+	}
+
+	// Notes too large to diff are stored without a diff
+	large := strings.Repeat("<p>line</p>", diff.MaxLines)
+	encoded, err = EncodeReleaseNotesDiff(large, large+"<p>more</p>")
+	if err != nil || encoded != nil {
+		t.Errorf("EncodeReleaseNotesDiff() of large notes = %s, %v, want nil, nil", encoded, err)
+	}
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	minRepositorySyncInterval = time.Hour
+	maxRepositorySyncInterval = 24 * time.Hour
+	// How often a repository is checked within the time it usually takes to publish a release
+	syncsPerReleaseInterval = 24
+)
+
+// nextSyncInterval returns how long to wait before a repository is synced again. Repositories are checked more often the
+// more frequently they publish releases. One that has been quiet for longer than usual has likely changed its pace, so the
+// time since its latest release counts as well.
+func nextSyncInterval(releaseDates []time.Time, now time.Time) time.Duration {
+	if len(releaseDates) == 0 {
+		return maxRepositorySyncInterval
+	}
+
+	latest := slices.MaxFunc(releaseDates, func(a, b time.Time) int { return a.Compare(b) })
+	releaseInterval := now.Sub(latest)
+
+	if len(releaseDates) > 1 {
+		earliest := slices.MinFunc(releaseDates, func(a, b time.Time) int { return a.Compare(b) })
+		releaseInterval = max(releaseInterval, latest.Sub(earliest)/time.Duration(len(releaseDates)-1))
+	}
+
+	return min(max(releaseInterval/syncsPerReleaseInterval, minRepositorySyncInterval), maxRepositorySyncInterval)
+}
+
+// failedSyncInterval returns how long to skip a repository that couldn't be synced. It waits as long as the repository has
+// been failing since its last successful sync, so the wait doubles with every failure until it reaches the longest interval.
+func failedSyncInterval(lastSyncedAt time.Time, now time.Time) time.Duration {
+	return min(max(now.Sub(lastSyncedAt), minRepositorySyncInterval), maxRepositorySyncInterval)
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextSyncInterval(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		releaseDates []time.Time
+		want         time.Duration
+	}{
+		{"no releases", nil, maxRepositorySyncInterval},
+		{"daily releases", []time.Time{now.Add(-2 * time.Hour), now.Add(-day), now.Add(-2 * day)}, time.Hour},
+		{"weekly releases", []time.Time{now.Add(-day), now.Add(-8 * day), now.Add(-15 * day)}, 7 * time.Hour},
+		{"daily releases that stopped", []time.Time{now.Add(-3 * day), now.Add(-4 * day), now.Add(-5 * day)}, 3 * time.Hour},
+		{"single old release", []time.Time{now.Add(-365 * day)}, maxRepositorySyncInterval},
+		{"unordered releases", []time.Time{now.Add(-15 * day), now.Add(-day), now.Add(-8 * day)}, 7 * time.Hour},
+		{"several releases at once", []time.Time{now.Add(-time.Minute), now.Add(-time.Minute)}, minRepositorySyncInterval},
+	}
+
+	for _, test := range tests {
+		got := nextSyncInterval(test.releaseDates, now)
+		if got != test.want {
+			t.Errorf("%s: nextSyncInterval() = %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFailedSyncInterval(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		lastSyncedAt time.Time
+		want         time.Duration
+	}{
+		{"just synced", now.Add(-time.Minute), minRepositorySyncInterval},
+		{"failing for a few hours", now.Add(-3 * time.Hour), 3 * time.Hour},
+		{"failing for days", now.Add(-72 * time.Hour), maxRepositorySyncInterval},
+		{"synced in the future", now.Add(time.Hour), minRepositorySyncInterval},
+	}
+
+	for _, test := range tests {
+		got := failedSyncInterval(test.lastSyncedAt, now)
+		if got != test.want {
+			t.Errorf("%s: failedSyncInterval() = %s, want %s", test.name, got, test.want)
+		}
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"slices"
+	"strings"
 	"time"
 
+	"github.com/benjasper/releases.one/internal/config"
 	"github.com/benjasper/releases.one/internal/github"
 	"github.com/benjasper/releases.one/internal/repository"
+	"github.com/benjasper/releases.one/internal/signals"
 	"github.com/benjasper/releases.one/pkg/keyedmutex"
 	"github.com/mitchellh/hashstructure/v2"
 	"golang.org/x/oauth2"
@@ -22,14 +26,16 @@ import (
 )
 
 type SyncService struct {
+	config            *config.Config
 	repository        *repository.Queries
 	githubOAuthConfig *oauth2.Config
 	repositoryMutex   *keyedmutex.KeyedMutex
 	userMutex         *keyedmutex.KeyedMutex
 }
 
-func NewSyncService(repository *repository.Queries, githubOAuthConfig *oauth2.Config) *SyncService {
+func NewSyncService(config *config.Config, repository *repository.Queries, githubOAuthConfig *oauth2.Config) *SyncService {
 	return &SyncService{
+		config:            config,
 		repository:        repository,
 		githubOAuthConfig: githubOAuthConfig,
 		repositoryMutex:   keyedmutex.NewKeyedMutex(),
@@ -45,25 +51,21 @@ func (s *SyncService) SyncUser(ctx context.Context, user *repository.User) error
 
 	syncStartedAt := time.Now()
 
-	githubService, newToken, err := github.NewGitHubService(ctx, s.githubOAuthConfig, (*oauth2.Token)(&user.GithubToken))
+	githubService, err := s.newGitHubService(ctx, user)
 	if err != nil {
 		return err
 	}
 
-	if newToken != nil {
-		slog.Info(fmt.Sprintf("Saving refreshed token for user: %s", user.Username))
-		err = s.repository.UpdateUserToken(ctx, repository.UpdateUserTokenParams{
-			GithubToken: repository.GitHubToken(*newToken),
-			ID:          user.ID,
-		})
-		if err != nil {
-			return err
-		}
+	// Only which repositories the user follows is synced here, their releases are refreshed on their own schedule
+	err = s.syncRepositoryStars(ctx, user, githubService)
+	if err != nil {
+		return err
 	}
 
-	err = s.syncRepositoriesAndReleases(ctx, user, githubService)
+	// Star lists are an optional extra, a failure here shouldn't block the rest of the sync
+	err = s.syncStarLists(ctx, user, githubService, syncStartedAt)
 	if err != nil {
-		return err
+		slog.Error(fmt.Sprintf("Failed to sync star lists for user %s: %s", user.Username, err.Error()))
 	}
 
 	result, err := s.repository.DeleteRepositoryStarsUpdatedBefore(ctx, repository.DeleteRepositoryStarsUpdatedBeforeParams{
@@ -80,6 +82,20 @@ func (s *SyncService) SyncUser(ctx context.Context, user *repository.User) error
 	}
 	slog.Info(fmt.Sprintf("Deleted %d repository stars for user: %s", rowsAffected, user.Username))
 
+	// Repositories that are due are refreshed right away, so newly starred ones show their releases without waiting for the schedule
+	dueRepositories, err := s.repository.GetRepositoriesDueForSyncForUser(ctx, repository.GetRepositoriesDueForSyncForUserParams{
+		Now:    sql.NullTime{Time: time.Now(), Valid: true},
+		UserID: user.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.refreshRepositories(ctx, githubService, dueRepositories)
+	if err != nil {
+		return err
+	}
+
 	err = s.repository.UpdateUserSyncedAt(ctx, repository.UpdateUserSyncedAtParams{
 		ID:           user.ID,
 		LastSyncedAt: syncStartedAt,
@@ -93,7 +109,30 @@ func (s *SyncService) SyncUser(ctx context.Context, user *repository.User) error
 	return nil
 }
 
-func (s *SyncService) syncRepositoriesAndReleases(ctx context.Context, user *repository.User, githubService *github.GitHubService) error {
+// newGitHubService creates a GitHub client with the user's token and saves the token in case it was refreshed.
+// The caller must hold the user's lock, as GitHub invalidates the previous refresh token with every refresh.
+func (s *SyncService) newGitHubService(ctx context.Context, user *repository.User) (*github.GitHubService, error) {
+	githubService, newToken, err := github.NewGitHubService(ctx, s.githubOAuthConfig, (*oauth2.Token)(&user.GithubToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if newToken != nil {
+		slog.Info(fmt.Sprintf("Saving refreshed token for user: %s", user.Username))
+		err = s.repository.UpdateUserToken(ctx, repository.UpdateUserTokenParams{
+			GithubToken: repository.GitHubToken(*newToken),
+			ID:          user.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return githubService, nil
+}
+
+// syncRepositoryStars records which repositories the user stars and watches
+func (s *SyncService) syncRepositoryStars(ctx context.Context, user *repository.User, githubService *github.GitHubService) error {
 	reposGroup, releasesCtx := errgroup.WithContext(ctx)
 
 	reposGroup.Go(func() error {
@@ -109,7 +148,7 @@ func (s *SyncService) syncRepositoriesAndReleases(ctx context.Context, user *rep
 			}
 
 			releasesErrGroup.Go(func() error {
-				return s.syncRepository(ctx, repo, user, repository.RepositoryStarTypeStar)
+				return s.syncRepositoryStar(ctx, repo, user, repository.RepositoryStarTypeStar)
 			})
 		}
 
@@ -138,7 +177,7 @@ func (s *SyncService) syncRepositoriesAndReleases(ctx context.Context, user *rep
 			}
 
 			releasesErrGroup.Go(func() error {
-				return s.syncRepository(ctx, repo, user, repository.RepositoryStarTypeWatch)
+				return s.syncRepositoryStar(ctx, repo, user, repository.RepositoryStarTypeWatch)
 			})
 		}
 
@@ -166,92 +205,169 @@ func (s *SyncService) syncRepositoriesAndReleases(ctx context.Context, user *rep
 	return nil
 }
 
-func (s *SyncService) syncRepository(ctx context.Context, repo *github.Repository, user *repository.User, starType repository.RepositoryStarType) error {
-	// Lock the syncing of this repository by name
-	s.repositoryMutex.Lock(repo.NameWithOwner)
-	defer s.repositoryMutex.Unlock(repo.NameWithOwner)
-
-	// Ignore private repositories for now
-	if repo.IsPrivate {
-		return nil
-	}
+func (s *SyncService) syncStarLists(ctx context.Context, user *repository.User, githubService *github.GitHubService, syncStartedAt time.Time) error {
+	for list, err := range githubService.GetStarLists(ctx) {
+		if err != nil {
+			return err
+		}
 
-	hash, err := hashstructure.Hash(repo, hashstructure.FormatV2, nil)
-	if err != nil {
-		return err
-	}
+		githubIDs := make([]string, 0, len(list.Items.Nodes))
+		for _, item := range list.Items.Nodes {
+			// Items are a union type, anything that isn't a repository comes back without an ID
+			if item.ID != "" {
+				githubIDs = append(githubIDs, item.ID)
+			}
+		}
 
-	githubRepo, err := s.repository.GetRepositoryByGithubID(ctx, repo.ID)
-	if err != nil && errors.Is(err, sql.ErrNoRows) {
-		slog.Info(fmt.Sprintf("No repository found, creating new repository: %s", repo.NameWithOwner))
+		// Private repositories the user hasn't opted into are never synced, so only known repositories are kept
+		repositoryIDs, err := s.repository.GetRepositoryIDsByGithubIDs(ctx, githubIDs)
+		if err != nil {
+			return err
+		}
 
-		// openGraphImageSize, err := githubService.GetImageSize(ctx, repo.OpenGraphImageURL)
-		// if err != nil {
-		// 	return err
-		// }
+		isNewList := false
 
-		err = s.repository.CreateRepository(ctx, repository.CreateRepositoryParams{
-			GithubID:     repo.ID,
-			Name:         repo.NameWithOwner,
-			Url:          repo.URL,
-			ImageUrl:     fmt.Sprintf("https://opengraph.githubassets.com/1/%s", repo.NameWithOwner),
-			ImageSize:    0,
-			Private:      repo.IsPrivate,
-			CreatedAt:    time.Now(),
-			UpdatedAt:    time.Now(),
-			LastSyncedAt: time.Now(),
-			Hash:         hash,
+		starList, err := s.repository.GetStarListByGithubID(ctx, repository.GetStarListByGithubIDParams{
+			GithubID: list.ID,
+			UserID:   user.ID,
 		})
-		if err != nil {
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			slog.Info(fmt.Sprintf("No star list found, creating new star list for user %s: %s", user.Username, list.Name))
+			isNewList = true
+
+			result, err := s.repository.InsertStarList(ctx, repository.InsertStarListParams{
+				UserID:    user.ID,
+				GithubID:  list.ID,
+				Name:      list.Name,
+				Slug:      list.Slug,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+
+			listID, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+
+			starList.ID = int32(listID)
+		} else if err != nil {
 			return err
+		} else {
+			err = s.repository.UpdateStarList(ctx, repository.UpdateStarListParams{
+				Name:      list.Name,
+				Slug:      list.Slug,
+				UpdatedAt: time.Now(),
+				ID:        starList.ID,
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		githubRepo, err = s.repository.GetRepositoryByGithubID(ctx, repo.ID)
+		for _, repo := range repositoryIDs {
+			err = s.repository.UpsertStarListRepository(ctx, repository.UpsertStarListRepositoryParams{
+				ListID:       starList.ID,
+				RepositoryID: repo.ID,
+				UpdatedAt:    time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.repository.DeleteStarListRepositoriesUpdatedBefore(ctx, repository.DeleteStarListRepositoriesUpdatedBeforeParams{
+			UpdatedAt: syncStartedAt,
+			ListID:    starList.ID,
+		})
 		if err != nil {
 			return err
 		}
-	} else if err != nil {
-		return err
-	} else {
-		// If the repository was updated in the last hour, don't sync it
-		if githubRepo.UpdatedAt.After(time.Now().Add(1 * time.Hour)) {
-			return nil
-		}
-
-		// In case the image changed, refetch the image size
-		// if repo.OpenGraphImageURL != githubRepo.ImageUrl {
-		// 	openGraphImageSize, err := githubService.GetImageSize(ctx, repo.OpenGraphImageURL)
-		// 	if err != nil {
-		// 		return err
-		// 	}
-		//
-		// 	githubRepo.ImageUrl = repo.OpenGraphImageURL
-		// 	githubRepo.ImageSize = int32(openGraphImageSize)
-		// }
 
-		// Check hash
-		if hash != githubRepo.Hash {
-			githubRepo.Hash = hash
-			githubRepo.UpdatedAt = time.Now()
-
-			slog.Info(fmt.Sprintf("Repository hash changed, updating repository: %s", repo.NameWithOwner))
-			_, err = s.repository.UpdateRepository(ctx, repository.UpdateRepositoryParams{
-				ID:           githubRepo.ID,
-				Url:          githubRepo.Url,
-				ImageUrl:     fmt.Sprintf("https://opengraph.githubassets.com/1/%s", githubRepo.Name),
-				ImageSize:    githubRepo.ImageSize,
-				Private:      githubRepo.Private,
-				CreatedAt:    githubRepo.CreatedAt,
-				UpdatedAt:    githubRepo.UpdatedAt,
-				LastSyncedAt: githubRepo.LastSyncedAt,
-				Hash:         githubRepo.Hash,
-			})
+		if isNewList {
+			err = s.seedRepositoryGroup(ctx, user, list.Name, repositoryIDs)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
+	result, err := s.repository.DeleteStarListsUpdatedBefore(ctx, repository.DeleteStarListsUpdatedBeforeParams{
+		UpdatedAt: syncStartedAt,
+		UserID:    user.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	slog.Info(fmt.Sprintf("Deleted %d star lists for user: %s", rowsAffected, user.Username))
+
+	return nil
+}
+
+// seedRepositoryGroup creates a group mirroring a newly discovered star list, groups are never touched afterwards so user edits stick
+func (s *SyncService) seedRepositoryGroup(ctx context.Context, user *repository.User, name string, repositoryIDs []repository.GetRepositoryIDsByGithubIDsRow) error {
+	_, err := s.repository.GetRepositoryGroupByName(ctx, repository.GetRepositoryGroupByNameParams{
+		UserID: user.ID,
+		Name:   name,
+	})
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	result, err := s.repository.CreateRepositoryGroup(ctx, repository.CreateRepositoryGroupParams{
+		UserID:    user.ID,
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	groupID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range repositoryIDs {
+		err = s.repository.InsertRepositoryGroupMember(ctx, repository.InsertRepositoryGroupMemberParams{
+			GroupID:      int32(groupID),
+			RepositoryID: repo.ID,
+			CreatedAt:    time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncRepositoryStar records that the user stars or watches a repository, creating the repository if it's new
+func (s *SyncService) syncRepositoryStar(ctx context.Context, repo *github.Repository, user *repository.User, starType repository.RepositoryStarType) error {
+	// Lock the syncing of this repository by name
+	s.repositoryMutex.Lock(repo.NameWithOwner)
+	defer s.repositoryMutex.Unlock(repo.NameWithOwner)
+
+	// Private repositories are opt-in, the star recorded below is what makes them visible to this user only
+	if repo.IsPrivate && !user.SyncPrivate {
+		return nil
+	}
+
+	githubRepo, err := s.upsertRepository(ctx, repo)
+	if err != nil {
+		return err
+	}
+
 	// Now check if the repository has already been starred by the user
 	result, err := s.repository.UpdateRepositoryStar(ctx, repository.UpdateRepositoryStarParams{
 		UpdatedAt:    time.Now(),
@@ -281,110 +397,960 @@ func (s *SyncService) syncRepository(ctx context.Context, repo *github.Repositor
 		}
 	}
 
-	releases, err := s.repository.GetReleases(ctx, githubRepo.ID)
+	return nil
+}
+
+// upsertRepository creates the repository or updates it, if it changed on GitHub. The caller must hold the repository's lock.
+func (s *SyncService) upsertRepository(ctx context.Context, repo *github.Repository) (repository.Repository, error) {
+	hash, err := hashstructure.Hash(repo, hashstructure.FormatV2, nil)
 	if err != nil {
-		return err
+		return repository.Repository{}, err
 	}
 
-	for _, ghRelease := range repo.Releases.Nodes {
-		var existingRelease *repository.Release
-		existingReleaseIdx := slices.IndexFunc(releases, func(release repository.Release) bool {
-			return release.TagName == ghRelease.TagName
-		})
+	githubRepo, err := s.repository.GetRepositoryByGithubID(ctx, repo.ID)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		slog.Info(fmt.Sprintf("No repository found, creating new repository: %s", repo.NameWithOwner))
 
-		if existingReleaseIdx >= 0 {
-			existingRelease = &releases[existingReleaseIdx]
+		// openGraphImageSize, err := githubService.GetImageSize(ctx, repo.OpenGraphImageURL)
+		// if err != nil {
+		// 	return err
+		// }
+
+		// Without a next sync time the repository is due right away, which fetches its releases. It stays without a last sync
+		// time until its releases were synced successfully, so the first successful sync knows to backfill its history.
+		err = s.repository.CreateRepository(ctx, repository.CreateRepositoryParams{
+			GithubID:     repo.ID,
+			Name:         repo.NameWithOwner,
+			Url:          repo.URL,
+			ImageUrl:     fmt.Sprintf("https://opengraph.githubassets.com/1/%s", repo.NameWithOwner),
+			ImageSize:    0,
+			Private:      repo.IsPrivate,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+			LastSyncedAt: sql.NullTime{Valid: false},
+			Hash:         hash,
+		})
+		if err != nil {
+			return repository.Repository{}, err
 		}
 
-		hash, err := hashstructure.Hash(ghRelease, hashstructure.FormatV2, nil)
+		return s.repository.GetRepositoryByGithubID(ctx, repo.ID)
+	} else if err != nil {
+		return repository.Repository{}, err
+	}
+
+	// In case the image changed, refetch the image size
+	// if repo.OpenGraphImageURL != githubRepo.ImageUrl {
+	// 	openGraphImageSize, err := githubService.GetImageSize(ctx, repo.OpenGraphImageURL)
+	// 	if err != nil {
+	// 		return err
+	// 	}
+	//
+	// 	githubRepo.ImageUrl = repo.OpenGraphImageURL
+	// 	githubRepo.ImageSize = int32(openGraphImageSize)
+	// }
+
+	// Check hash
+	if hash != githubRepo.Hash {
+		githubRepo.Hash = hash
+		githubRepo.UpdatedAt = time.Now()
+
+		slog.Info(fmt.Sprintf("Repository hash changed, updating repository: %s", repo.NameWithOwner))
+		_, err = s.repository.UpdateRepository(ctx, repository.UpdateRepositoryParams{
+			ID:           githubRepo.ID,
+			Url:          githubRepo.Url,
+			ImageUrl:     fmt.Sprintf("https://opengraph.githubassets.com/1/%s", githubRepo.Name),
+			ImageSize:    githubRepo.ImageSize,
+			Private:      repo.IsPrivate,
+			CreatedAt:    githubRepo.CreatedAt,
+			UpdatedAt:    githubRepo.UpdatedAt,
+			LastSyncedAt: githubRepo.LastSyncedAt,
+			Hash:         githubRepo.Hash,
+		})
 		if err != nil {
-			return err
+			return repository.Repository{}, err
 		}
+	}
 
-		if existingRelease == nil {
-			slog.Info(fmt.Sprintf("Release not found, creating new release for user %s and repository %s: %s", user.Username, githubRepo.Name, ghRelease.TagName))
-			author := ghRelease.Author.Name
-			if author == "" {
-				author = ghRelease.Author.Login
-			}
+	return githubRepo, nil
+}
 
-			err = s.repository.InsertRelease(ctx, repository.InsertReleaseParams{
-				GithubID:         ghRelease.ID,
-				RepositoryID:     githubRepo.ID,
-				Name:             ghRelease.Name,
-				TagName:          ghRelease.TagName,
-				Url:              ghRelease.URL,
-				Description:      string(mdToHTML([]byte(ghRelease.Description))),
-				DescriptionShort: ghRelease.ShortDescriptionHTML,
-				Author:           sql.NullString{String: author, Valid: author != ""},
-				ReleasedAt:       ghRelease.PublishedAt,
-				IsPrerelease:     ghRelease.IsPrerelease,
-				CreatedAt:        time.Now(),
-				UpdatedAt:        time.Now(),
-				Hash:             hash,
-			})
-			if err != nil {
-				return err
-			}
-		} else if hash != existingRelease.Hash {
-			author := ghRelease.Author.Name
-			if author == "" {
-				author = ghRelease.Author.Login
-			}
+// SyncRepositories refreshes the releases of up to limit repositories that are due, using the tokens of their followers.
+// Every repository is fetched once no matter how many users follow it, in batches of github.RepositoriesBatchSize.
+// It returns how many repositories were refreshed.
+func (s *SyncService) SyncRepositories(ctx context.Context, limit int) (int, error) {
+	dueRepositories, err := s.repository.GetRepositoriesDueForSync(ctx, repository.GetRepositoriesDueForSyncParams{
+		Now:   sql.NullTime{Time: time.Now(), Valid: true},
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
 
-			slog.Info(fmt.Sprintf("Release hash changed (old: %d, new: %d), updating release: %s for repository %s", existingRelease.Hash, hash, ghRelease.Name, githubRepo.Name))
-			_, err = s.repository.UpdateRelease(ctx, repository.UpdateReleaseParams{
-				ID:               existingRelease.ID,
-				GithubID:         ghRelease.ID,
-				Name:             ghRelease.Name,
-				Url:              ghRelease.URL,
-				Description:      string(mdToHTML([]byte(ghRelease.Description))),
-				DescriptionShort: ghRelease.ShortDescriptionHTML,
-				Author:           sql.NullString{String: author, Valid: author != ""},
-				ReleasedAt:       ghRelease.PublishedAt,
-				IsPrerelease:     ghRelease.IsPrerelease,
-				UpdatedAt:        time.Now(),
-				Hash:             hash,
-			})
+	// Private repositories can only be read with the token of someone following them, public ones with any token,
+	// so those are spread over all users whose token is used anyway to not drain a single user's rate limit
+	users := map[int32]*repository.User{}
+	repositoriesByUser := map[int32][]repository.Repository{}
+	var publicRepositories []repository.Repository
+	for _, dueRepository := range dueRepositories {
+		user, err := s.repository.GetRepositorySyncUser(ctx, dueRepository.ID)
+		if err != nil && errors.Is(err, sql.ErrNoRows) {
+			slog.Info(fmt.Sprintf("No follower with a GitHub token found, postponing repository: %s", dueRepository.Name))
+			err = s.postponeRepository(ctx, &dueRepository)
 			if err != nil {
-				return err
+				return 0, err
 			}
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+
+		users[user.ID] = &user
+		if dueRepository.Private {
+			repositoriesByUser[user.ID] = append(repositoriesByUser[user.ID], dueRepository)
+		} else {
+			publicRepositories = append(publicRepositories, dueRepository)
 		}
 	}
 
-	// Find the date of the 10th most recent release
-	var oldestRelease *repository.Release
-	if len(releases) > 10 {
-		oldestRelease = &releases[len(releases)-10]
+	userIDs := slices.Sorted(maps.Keys(users))
+	i := 0
+	for batch := range slices.Chunk(publicRepositories, github.RepositoriesBatchSize) {
+		userID := userIDs[i%len(userIDs)]
+		repositoriesByUser[userID] = append(repositoriesByUser[userID], batch...)
+		i++
+	}
 
-		result, err = s.repository.DeleteReleasesOlderThan(ctx, repository.DeleteReleasesOlderThanParams{
-			ReleasedAt:   oldestRelease.ReleasedAt,
-			RepositoryID: githubRepo.ID,
-		})
-		if err != nil {
-			return err
+	refreshed := 0
+	for _, userID := range userIDs {
+		userRepositories := repositoriesByUser[userID]
+		if len(userRepositories) == 0 {
+			continue
 		}
 
-		rowsAffected, err := result.RowsAffected()
+		// A failing token only holds back the repositories assigned to it, the next run picks another follower for them
+		err = s.refreshRepositoriesWithTokenOf(ctx, users[userID], userRepositories)
 		if err != nil {
-			return err
+			slog.Error(fmt.Sprintf("Failed to refresh repositories with the token of user %s: %s", users[userID].Username, err.Error()))
+			for i := range userRepositories {
+				err = s.postponeRepository(ctx, &userRepositories[i])
+				if err != nil {
+					return refreshed, err
+				}
+			}
+			continue
 		}
-		slog.Info(fmt.Sprintf("Deleted %d releases older than %s for repository: %s", rowsAffected, oldestRelease.ReleasedAt.String(), repo.NameWithOwner))
+
+		refreshed += len(userRepositories)
 	}
 
-	return nil
+	return refreshed, nil
 }
 
-func mdToHTML(md []byte) []byte {
-	// create markdown parser with extensions
-	extensions := parser.CommonExtensions | parser.AutoHeadingIDs | parser.NoEmptyLineBeforeBlock
-	p := parser.NewWithExtensions(extensions)
-	doc := p.Parse(md)
+func (s *SyncService) refreshRepositoriesWithTokenOf(ctx context.Context, user *repository.User, repositories []repository.Repository) error {
+	s.userMutex.Lock(user.Username)
+	defer s.userMutex.Unlock(user.Username)
 
-	// create HTML renderer with extensions
-	htmlFlags := html.CommonFlags | html.HrefTargetBlank
-	opts := html.RendererOptions{Flags: htmlFlags}
-	renderer := html.NewRenderer(opts)
+	// The token could have been refreshed since the user was loaded
+	currentUser, err := s.repository.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
 
-	return markdown.Render(doc, renderer)
+	githubService, err := s.newGitHubService(ctx, &currentUser)
+	if err != nil {
+		return err
+	}
+
+	return s.refreshRepositories(ctx, githubService, repositories)
+}
+
+// refreshRepositories fetches the repositories and their latest releases from GitHub in batches and syncs them
+func (s *SyncService) refreshRepositories(ctx context.Context, githubService *github.GitHubService, repositories []repository.Repository) error {
+	for batch := range slices.Chunk(repositories, github.RepositoriesBatchSize) {
+		// One query fetches the same number of releases for every repository, enough for the follower asking for the most
+		ids := make([]string, len(batch))
+		fetchDepth := 0
+		for i, batchRepository := range batch {
+			ids[i] = batchRepository.GithubID
+
+			repositoryFetchDepth, err := s.releaseFetchDepth(ctx, batchRepository.ID)
+			if err != nil {
+				return err
+			}
+			fetchDepth = max(fetchDepth, repositoryFetchDepth)
+		}
+
+		ghRepos, failedIDs, err := githubService.GetRepositories(ctx, ids, fetchDepth)
+		if err != nil {
+			// A failing batch doesn't hold back the ones after it, its repositories are tried again after a backoff
+			slog.Error(fmt.Sprintf("Failed to fetch a batch of %d repositories, postponing them: %s", len(batch), err.Error()))
+			for i := range batch {
+				err = s.postponeRepository(ctx, &batch[i])
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if len(failedIDs) > 0 {
+			slog.Info(fmt.Sprintf("Failed to fetch %d of %d repositories from GitHub", len(failedIDs), len(ids)))
+		}
+
+		releasesErrGroup, releasesCtx := errgroup.WithContext(ctx)
+		releasesErrGroup.SetLimit(10)
+
+		for i, ghRepo := range ghRepos {
+			if ghRepo == nil {
+				// Deleted repositories, ones the token lost access to and ones GitHub failed to load are tried again later,
+				// until nobody follows them anymore
+				slog.Info(fmt.Sprintf("Repository not found or failed on GitHub, postponing repository: %s", batch[i].Name))
+				err = s.postponeRepository(ctx, &batch[i])
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			releasesErrGroup.Go(func() error {
+				err := s.syncRepositoryReleases(releasesCtx, githubService, ghRepo, fetchDepth)
+				if err == nil {
+					return nil
+				}
+
+				// Only errors that keep the repository from being postponed stop the other repositories
+				slog.Error(fmt.Sprintf("Failed to sync releases, postponing repository %s: %s", batch[i].Name, err.Error()))
+				return s.postponeRepository(releasesCtx, &batch[i])
+			})
+		}
+
+		err = releasesErrGroup.Wait()
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				slog.Error(fmt.Sprintf("Error syncing releases (context canceled): %s", context.Cause(releasesCtx)))
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postponeRepository skips a repository that couldn't be synced. The longer it has been failing, the longer it is skipped.
+func (s *SyncService) postponeRepository(ctx context.Context, repo *repository.Repository) error {
+	now := time.Now()
+
+	// A repository that was never synced has been failing since it was added
+	lastSyncedAt := repo.CreatedAt
+	if repo.LastSyncedAt.Valid {
+		lastSyncedAt = repo.LastSyncedAt.Time
+	}
+
+	// The last sync time is kept as it is, it only changes on a successful sync
+	return s.repository.UpdateRepositorySyncSchedule(ctx, repository.UpdateRepositorySyncScheduleParams{
+		LastSyncedAt: repo.LastSyncedAt,
+		NextSyncAt:   sql.NullTime{Time: now.Add(failedSyncInterval(lastSyncedAt, now)), Valid: true},
+		ID:           repo.ID,
+	})
+}
+
+// syncRepositoryReleases syncs the releases of a repository and schedules its next sync
+func (s *SyncService) syncRepositoryReleases(ctx context.Context, githubService *github.GitHubService, repo *github.Repository, fetchDepth int) error {
+	// Lock the syncing of this repository by name
+	s.repositoryMutex.Lock(repo.NameWithOwner)
+	defer s.repositoryMutex.Unlock(repo.NameWithOwner)
+
+	githubRepo, err := s.upsertRepository(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	releases, err := s.repository.GetReleases(ctx, githubRepo.ID)
+	if err != nil {
+		return err
+	}
+
+	// Releases discovered during a repository's first successful sync are history, not news. Failed attempts before it
+	// schedule the next one, but never set the last sync time.
+	isFirstSync := !githubRepo.LastSyncedAt.Valid && len(releases) == 0
+
+	releaseDates := make([]time.Time, 0, len(releases))
+	for _, release := range releases {
+		releaseDates = append(releaseDates, release.ReleasedAt)
+	}
+
+	ghReleases := repo.Releases.Nodes
+
+	// A new repository gets its history backfilled, as far back as releases are kept anyway
+	if isFirstSync && len(ghReleases) >= fetchDepth {
+		retention, err := s.releaseRetention(ctx, githubRepo.ID)
+		if err != nil {
+			return err
+		}
+
+		if retention > len(ghReleases) {
+			ghReleases, err = s.fetchReleaseHistory(ctx, githubService, repo.NameWithOwner, retention)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// None of the fetched releases being known means more releases than the fetch depth were published since the
+	// last sync, so page further back until a known release shows up instead of silently skipping the ones between
+	if !isFirstSync && len(releases) > 0 && len(ghReleases) >= fetchDepth && !containsKnownRelease(ghReleases, releases) {
+		ghReleases, err = s.fetchReleasesUntilKnown(ctx, githubService, repo.NameWithOwner, releases)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Drafts aren't released yet, they are picked up once they get published
+	ghReleases = slices.DeleteFunc(slices.Clone(ghReleases), func(ghRelease github.Release) bool {
+		return ghRelease.IsDraft
+	})
+
+	for _, ghRelease := range ghReleases {
+		var existingRelease *repository.Release
+		existingReleaseIdx := indexOfRelease(releases, &ghRelease)
+
+		if existingReleaseIdx >= 0 {
+			existingRelease = &releases[existingReleaseIdx]
+		}
+
+		hash, err := hashstructure.Hash(ghRelease, hashstructure.FormatV2, nil)
+		if err != nil {
+			return err
+		}
+
+		description := string(mdToHTML([]byte(ghRelease.Description)))
+		releaseSignals := signals.Detect(ghRelease.Description)
+
+		major, ok := MajorVersion(ghRelease.TagName)
+		majorVersion := sql.NullInt32{Int32: int32(major), Valid: ok}
+
+		var releaseID int32
+		if existingRelease == nil {
+			slog.Info(fmt.Sprintf("Release not found, creating new release for repository %s: %s", githubRepo.Name, ghRelease.TagName))
+			releaseDates = append(releaseDates, ghRelease.PublishedAt)
+			author := ghRelease.Author.Name
+			if author == "" {
+				author = ghRelease.Author.Login
+			}
+
+			result, err := s.repository.InsertRelease(ctx, repository.InsertReleaseParams{
+				GithubID:          ghRelease.ID,
+				RepositoryID:      githubRepo.ID,
+				Name:              ghRelease.Name,
+				TagName:           ghRelease.TagName,
+				Url:               ghRelease.URL,
+				Description:       description,
+				DescriptionShort:  ghRelease.ShortDescriptionHTML,
+				DescriptionText:   HTMLToText(description),
+				IsSecurity:        releaseSignals.IsSecurity,
+				IsBreaking:        releaseSignals.IsBreaking,
+				HasDeprecation:    releaseSignals.HasDeprecation,
+				HasMigrationGuide: releaseSignals.HasMigrationGuide,
+				VulnerabilityIds:  strings.Join(releaseSignals.VulnerabilityIDs, ","),
+				SignalsDetected:   true,
+				Author:            sql.NullString{String: author, Valid: author != ""},
+				MajorVersion:      majorVersion,
+				ReleasedAt:        ghRelease.PublishedAt,
+				GithubCreatedAt:   sql.NullTime{Time: ghRelease.CreatedAt, Valid: true},
+				FirstSeenAt:       sql.NullTime{Time: time.Now(), Valid: true},
+				IsBackfill:        isFirstSync,
+				IsPrerelease:      ghRelease.IsPrerelease,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+				Hash:              hash,
+			})
+			if err != nil {
+				return err
+			}
+
+			insertedID, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+
+			releaseID = int32(insertedID)
+
+			// Snoozing until the next major version ends once it is out, which shows the releases hidden in the meantime
+			if majorVersion.Valid {
+				err = s.repository.ClearRepositoryStarSnoozesBeforeMajor(ctx, repository.ClearRepositoryStarSnoozesBeforeMajorParams{
+					RepositoryID:      githubRepo.ID,
+					SnoozedUntilMajor: majorVersion,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		} else if hash != existingRelease.Hash || (existingRelease.DescriptionText == "" && existingRelease.Description != "") || existingRelease.RetractedAt.Valid {
+			// Releases stored before search existed have no extracted text yet, so they are updated once
+			author := ghRelease.Author.Name
+			if author == "" {
+				author = ghRelease.Author.Login
+			}
+
+			// Keep the previous version around whenever something a reader would notice changed
+			editedAt := existingRelease.EditedAt
+			if existingRelease.Name != ghRelease.Name || existingRelease.TagName != ghRelease.TagName || existingRelease.Description != description || existingRelease.IsPrerelease != ghRelease.IsPrerelease {
+				slog.Info(fmt.Sprintf("Release was edited, recording previous version of release: %s for repository %s", ghRelease.Name, githubRepo.Name))
+				editedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+				// Feeds show what changed, diffing here once keeps them from diffing on every request
+				releaseNotesDiff, err := EncodeReleaseNotesDiff(existingRelease.Description, description)
+				if err != nil {
+					return err
+				}
+
+				err = s.repository.InsertReleaseEdit(ctx, repository.InsertReleaseEditParams{
+					ReleaseID:    existingRelease.ID,
+					Name:         existingRelease.Name,
+					TagName:      existingRelease.TagName,
+					Description:  existingRelease.Description,
+					IsPrerelease: existingRelease.IsPrerelease,
+					EditedAt:     editedAt.Time,
+					Diff:         releaseNotesDiff,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			slog.Info(fmt.Sprintf("Release hash changed (old: %d, new: %d), updating release: %s for repository %s", existingRelease.Hash, hash, ghRelease.Name, githubRepo.Name))
+			_, err = s.repository.UpdateRelease(ctx, repository.UpdateReleaseParams{
+				ID:                existingRelease.ID,
+				GithubID:          ghRelease.ID,
+				TagName:           ghRelease.TagName,
+				Name:              ghRelease.Name,
+				Url:               ghRelease.URL,
+				Description:       description,
+				DescriptionShort:  ghRelease.ShortDescriptionHTML,
+				DescriptionText:   HTMLToText(description),
+				IsSecurity:        releaseSignals.IsSecurity,
+				IsBreaking:        releaseSignals.IsBreaking,
+				HasDeprecation:    releaseSignals.HasDeprecation,
+				HasMigrationGuide: releaseSignals.HasMigrationGuide,
+				VulnerabilityIds:  strings.Join(releaseSignals.VulnerabilityIDs, ","),
+				Author:            sql.NullString{String: author, Valid: author != ""},
+				MajorVersion:      majorVersion,
+				ReleasedAt:        ghRelease.PublishedAt,
+				GithubCreatedAt:   sql.NullTime{Time: ghRelease.CreatedAt, Valid: true},
+				IsPrerelease:      ghRelease.IsPrerelease,
+				EditedAt:          editedAt,
+				UpdatedAt:         time.Now(),
+				Hash:              hash,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if existingRelease != nil {
+			releaseID = existingRelease.ID
+		}
+
+		// Assets are synced on every run, their download counts change without the release changing
+		err = s.syncReleaseAssets(ctx, releaseID, ghRelease.ReleaseAssets.Nodes)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.retractDeletedReleases(ctx, releases, ghReleases)
+	if err != nil {
+		return err
+	}
+
+	// Advisories change rarely, so they are only checked once a day per repository
+	if !githubRepo.AdvisoriesSyncedAt.Valid || githubRepo.AdvisoriesSyncedAt.Time.Before(time.Now().Add(-24*time.Hour)) {
+		err = s.syncAdvisories(ctx, githubService, &githubRepo)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to sync security advisories for repository %s: %s", githubRepo.Name, err.Error()))
+		}
+	}
+
+	retention, err := s.releaseRetention(ctx, githubRepo.ID)
+	if err != nil {
+		return err
+	}
+
+	// Releases are ordered newest first, find the oldest one that is still retained
+	var oldestRelease *repository.Release
+	if len(releases) > retention {
+		oldestRelease = &releases[retention-1]
+
+		result, err := s.repository.DeleteReleasesOlderThan(ctx, repository.DeleteReleasesOlderThanParams{
+			ReleasedAt:   oldestRelease.ReleasedAt,
+			RepositoryID: githubRepo.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		slog.Info(fmt.Sprintf("Deleted %d releases older than %s for repository: %s", rowsAffected, oldestRelease.ReleasedAt.String(), repo.NameWithOwner))
+	}
+
+	syncedAt := time.Now()
+	return s.repository.UpdateRepositorySyncSchedule(ctx, repository.UpdateRepositorySyncScheduleParams{
+		LastSyncedAt: sql.NullTime{Time: syncedAt, Valid: true},
+		NextSyncAt:   sql.NullTime{Time: syncedAt.Add(nextSyncInterval(releaseDates, syncedAt)), Valid: true},
+		ID:           githubRepo.ID,
+	})
+}
+
+// fetchReleaseHistory pages through a repository's releases on GitHub, returning up to limit of the newest ones
+func (s *SyncService) fetchReleaseHistory(ctx context.Context, githubService *github.GitHubService, nameWithOwner string, limit int) ([]github.Release, error) {
+	var releases []github.Release
+	for release, err := range githubService.GetRepositoryReleases(ctx, nameWithOwner, limit) {
+		if err != nil {
+			return nil, err
+		}
+
+		releases = append(releases, *release)
+	}
+
+	slog.Info(fmt.Sprintf("Fetched %d releases of history for repository: %s", len(releases), nameWithOwner))
+
+	return releases, nil
+}
+
+// fetchReleasesUntilKnown pages through a repository's releases on GitHub, newest first, until it reaches one of
+// the known releases or the configured catch up limit
+func (s *SyncService) fetchReleasesUntilKnown(ctx context.Context, githubService *github.GitHubService, nameWithOwner string, knownReleases []repository.Release) ([]github.Release, error) {
+	var releases []github.Release
+	for release, err := range githubService.GetRepositoryReleases(ctx, nameWithOwner, s.config.ReleaseCatchUpLimit) {
+		if err != nil {
+			return nil, err
+		}
+
+		releases = append(releases, *release)
+
+		if containsKnownRelease([]github.Release{*release}, knownReleases) {
+			break
+		}
+	}
+
+	slog.Info(fmt.Sprintf("Caught up on %d releases for repository: %s", len(releases), nameWithOwner))
+
+	return releases, nil
+}
+
+// containsKnownRelease reports whether any of the GitHub releases is already stored
+func containsKnownRelease(ghReleases []github.Release, knownReleases []repository.Release) bool {
+	return slices.ContainsFunc(ghReleases, func(ghRelease github.Release) bool {
+		return indexOfRelease(knownReleases, &ghRelease) >= 0
+	})
+}
+
+// indexOfRelease finds the stored release for a GitHub release by its stable node ID. Releases that were deleted
+// and recreated under the same tag get a new node ID, so the tag is used as a fallback.
+func indexOfRelease(releases []repository.Release, ghRelease *github.Release) int {
+	idx := slices.IndexFunc(releases, func(release repository.Release) bool {
+		return release.GithubID == ghRelease.ID
+	})
+	if idx >= 0 {
+		return idx
+	}
+
+	return slices.IndexFunc(releases, func(release repository.Release) bool {
+		return release.TagName == ghRelease.TagName
+	})
+}
+
+// retractDeletedReleases marks stored releases as retracted when they were deleted on GitHub or turned back into drafts
+func (s *SyncService) retractDeletedReleases(ctx context.Context, releases []repository.Release, ghReleases []github.Release) error {
+	for _, release := range deletedReleases(releases, ghReleases) {
+		slog.Info(fmt.Sprintf("Release no longer exists on GitHub, retracting release: %s", release.TagName))
+		err := s.repository.RetractRelease(ctx, repository.RetractReleaseParams{
+			RetractedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:          release.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deletedReleases returns the stored releases that fall into the window of fetched releases but weren't among them
+func deletedReleases(releases []repository.Release, ghReleases []github.Release) []repository.Release {
+	if len(ghReleases) == 0 {
+		return nil
+	}
+
+	// Releases are fetched newest created first, so every release created after the oldest fetched one was fetched.
+	// Publishing doesn't count, a draft published today can be older than the whole window.
+	oldestFetched := ghReleases[0].CreatedAt
+	for _, ghRelease := range ghReleases {
+		if ghRelease.CreatedAt.Before(oldestFetched) {
+			oldestFetched = ghRelease.CreatedAt
+		}
+	}
+
+	var deleted []repository.Release
+	for _, release := range releases {
+		// Releases stored before their creation time was known can't be placed in the window, they are left alone
+		if release.RetractedAt.Valid || !release.GithubCreatedAt.Valid || !release.GithubCreatedAt.Time.After(oldestFetched) {
+			continue
+		}
+
+		found := slices.ContainsFunc(ghReleases, func(ghRelease github.Release) bool {
+			return indexOfRelease([]repository.Release{release}, &ghRelease) >= 0
+		})
+		if !found {
+			deleted = append(deleted, release)
+		}
+	}
+
+	return deleted
+}
+
+// releaseFetchDepth returns how many of the latest releases are fetched for a repository, the largest fetch depth of any follower wins
+func (s *SyncService) releaseFetchDepth(ctx context.Context, repositoryID int32) (int, error) {
+	fetchDepths, err := s.repository.GetReleaseFetchDepthsForRepository(ctx, repositoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	fetchDepth := s.config.ReleaseFetchDepth
+	for _, userFetchDepth := range fetchDepths {
+		if userFetchDepth.Valid {
+			fetchDepth = max(fetchDepth, int(userFetchDepth.Int32))
+		}
+	}
+
+	return fetchDepth, nil
+}
+
+// releaseRetention returns how many releases are kept for a repository. Releases are shared between everyone
+// following the repository, so the largest retention of any follower wins.
+func (s *SyncService) releaseRetention(ctx context.Context, repositoryID int32) (int, error) {
+	retentions, err := s.repository.GetReleaseRetentionsForRepository(ctx, repositoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(retentions) == 0 {
+		return s.config.ReleaseRetention, nil
+	}
+
+	retention := 0
+	for _, userRetention := range retentions {
+		effectiveRetention := s.config.ReleaseRetention
+		if userRetention.Valid {
+			effectiveRetention = int(userRetention.Int32)
+		}
+
+		retention = max(retention, effectiveRetention)
+	}
+
+	return retention, nil
+}
+
+func (s *SyncService) syncReleaseAssets(ctx context.Context, releaseID int32, assets []github.ReleaseAsset) error {
+	// Datetime columns have no fractional seconds, anything finer would make fresh rows look stale
+	assetsSyncedAt := time.Now().Truncate(time.Second)
+
+	for _, asset := range assets {
+		err := s.repository.UpsertReleaseAsset(ctx, repository.UpsertReleaseAssetParams{
+			ReleaseID:     releaseID,
+			GithubID:      asset.ID,
+			Name:          asset.Name,
+			ContentType:   asset.ContentType,
+			Size:          asset.Size,
+			DownloadCount: int32(asset.DownloadCount),
+			Url:           asset.DownloadURL,
+			CreatedAt:     assetsSyncedAt,
+			UpdatedAt:     assetsSyncedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Assets deleted on GitHub weren't touched above
+	return s.repository.DeleteReleaseAssetsUpdatedBefore(ctx, repository.DeleteReleaseAssetsUpdatedBeforeParams{
+		UpdatedAt: assetsSyncedAt,
+		ReleaseID: releaseID,
+	})
+}
+
+// syncAdvisories links the repository's releases to the security advisories they are the first patched version of
+func (s *SyncService) syncAdvisories(ctx context.Context, githubService *github.GitHubService, githubRepo *repository.Repository) error {
+	advisories, err := githubService.GetRepositoryAdvisories(ctx, githubRepo.Name)
+	if err != nil {
+		return err
+	}
+
+	releases, err := s.repository.GetReleases(ctx, githubRepo.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, advisory := range advisories {
+		for _, vulnerability := range advisory.Vulnerabilities {
+			for _, release := range releases {
+				if !IsPatchedVersion(release.TagName, vulnerability.PatchedVersions) {
+					continue
+				}
+
+				slog.Info(fmt.Sprintf("Linking release %s of repository %s to advisory %s", release.TagName, githubRepo.Name, advisory.GhsaID))
+				err = s.repository.UpsertReleaseAdvisory(ctx, repository.UpsertReleaseAdvisoryParams{
+					ReleaseID:   release.ID,
+					GhsaID:      advisory.GhsaID,
+					CveID:       sql.NullString{String: advisory.CveID, Valid: advisory.CveID != ""},
+					Severity:    advisory.Severity,
+					Summary:     advisory.Summary,
+					Url:         advisory.HTMLURL,
+					PublishedAt: advisory.PublishedAt,
+					CreatedAt:   time.Now(),
+				})
+				if err != nil {
+					return err
+				}
+
+				err = s.repository.MarkReleaseSecurity(ctx, release.ID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return s.repository.UpdateRepositoryAdvisoriesSyncedAt(ctx, repository.UpdateRepositoryAdvisoriesSyncedAtParams{
+		AdvisoriesSyncedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:                 githubRepo.ID,
+	})
+}
+
+func mdToHTML(md []byte) []byte {
+	// create markdown parser with extensions
+	extensions := parser.CommonExtensions | parser.AutoHeadingIDs | parser.NoEmptyLineBeforeBlock
+	p := parser.NewWithExtensions(extensions)
+	doc := p.Parse(md)
+
+	// create HTML renderer with extensions
+	htmlFlags := html.CommonFlags | html.HrefTargetBlank
+	opts := html.RendererOptions{Flags: htmlFlags}
+	renderer := html.NewRenderer(opts)
+
+	return markdown.Render(doc, renderer)
+}
+
+// DeleteUser deletes a user and everything that belongs to them, then revokes the GitHub grant so the token doesn't outlive the account.
+// Repositories nobody else follows anymore are deleted with their releases, it returns how many.
+func (s *SyncService) DeleteUser(ctx context.Context, userID int32) (int64, error) {
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	// A running sync must not write stars for the user while they are deleted
+	s.userMutex.Lock(user.Username)
+	defer s.userMutex.Unlock(user.Username)
+
+	repositoryIDs, err := s.followedRepositoryIDs(ctx, user.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Stars, feeds, settings, sessions and everything else of the user are deleted with it
+	err = s.repository.DeleteUser(ctx, user.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	// GitHub being unavailable must not keep anyone from deleting their account, the token was loaded before and is gone locally
+	// now, so a failed revocation is only logged and the user can still revoke the grant in their GitHub settings
+	if user.GithubToken.AccessToken != "" {
+		err = github.RevokeGrant(ctx, s.githubOAuthConfig, (*oauth2.Token)(&user.GithubToken))
+		if err != nil {
+			slog.Error(fmt.Sprintf("Failed to revoke GitHub grant of deleted user %s: %s", user.Username, err.Error()))
+		}
+	}
+
+	deletedRepositories := int64(0)
+	for chunk := range slices.Chunk(repositoryIDs, 1000) {
+		result, err := s.repository.DeleteUnfollowedRepositories(ctx, chunk)
+		if err != nil {
+			return deletedRepositories, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return deletedRepositories, err
+		}
+		deletedRepositories += rowsAffected
+	}
+
+	return deletedRepositories, nil
+}
+
+// followedRepositoryIDs returns every repository a user references, through stars, groups, lists or bookmarks
+func (s *SyncService) followedRepositoryIDs(ctx context.Context, userID int32) ([]int32, error) {
+	repositoryIDs := map[int32]struct{}{}
+
+	stars, err := s.repository.GetStarsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, star := range stars {
+		repositoryIDs[star.ID] = struct{}{}
+	}
+
+	groupMembers, err := s.repository.GetRepositoryGroupMembersForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range groupMembers {
+		repositoryIDs[member.RepositoryID] = struct{}{}
+	}
+
+	listRepositories, err := s.repository.GetStarListRepositoriesForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, listRepository := range listRepositories {
+		repositoryIDs[listRepository.RepositoryID] = struct{}{}
+	}
+
+	bookmarks, err := s.repository.GetBookmarksForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, bookmark := range bookmarks {
+		repositoryIDs[bookmark.RepositoryID] = struct{}{}
+	}
+
+	return slices.Sorted(maps.Keys(repositoryIDs)), nil
+}
+
+// ReencryptTokens rewrites every GitHub token that isn't encrypted with the primary key yet, which completes a key rotation.
+// Users whose token can't be decrypted anymore are skipped, they have to log in again.
+func (s *SyncService) ReencryptTokens(ctx context.Context) (int, error) {
+	keyID := repository.PrimaryTokenKeyID()
+	reencrypted := 0
+	afterID := int32(0)
+
+	for {
+		users, err := s.repository.GetUsersWithTokenKeyOtherThan(ctx, repository.GetUsersWithTokenKeyOtherThanParams{
+			KeyID:   keyID,
+			AfterID: afterID,
+			Limit:   100,
+		})
+		if err != nil {
+			return reencrypted, err
+		}
+
+		if len(users) == 0 {
+			return reencrypted, nil
+		}
+
+		for _, staleUser := range users {
+			afterID = staleUser.ID
+
+			err = s.reencryptToken(ctx, staleUser.ID, staleUser.Username)
+			if err != nil {
+				slog.Error(fmt.Sprintf("Failed to re-encrypt token for user %s: %s", staleUser.Username, err))
+				continue
+			}
+
+			reencrypted++
+		}
+	}
+}
+
+// BackfillReleasesFirstSeen dates releases stored before first seen times were tracked. They were first seen when they were
+// stored and count as backfilled history, so they never show up as new. It returns how many releases were updated.
+func (s *SyncService) BackfillReleasesFirstSeen(ctx context.Context) (int64, error) {
+	backfilled := int64(0)
+
+	for {
+		result, err := s.repository.BackfillReleasesFirstSeen(ctx, 1000)
+		if err != nil {
+			return backfilled, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return backfilled, err
+		}
+
+		if rowsAffected == 0 {
+			return backfilled, nil
+		}
+
+		backfilled += rowsAffected
+	}
+}
+
+// BackfillReleaseSignals detects signals for releases stored before they were detected during sync. Their markdown
+// wasn't stored, so the rendered notes are turned back into plain notes. It returns how many releases were updated.
+func (s *SyncService) BackfillReleaseSignals(ctx context.Context) (int, error) {
+	backfilled := 0
+	afterID := int32(0)
+
+	for {
+		releases, err := s.repository.GetReleasesWithoutSignals(ctx, repository.GetReleasesWithoutSignalsParams{
+			AfterID: afterID,
+			Limit:   500,
+		})
+		if err != nil {
+			return backfilled, err
+		}
+
+		if len(releases) == 0 {
+			return backfilled, nil
+		}
+
+		for _, release := range releases {
+			afterID = release.ID
+
+			releaseSignals := signals.Detect(HTMLToNotes(release.Description))
+			err = s.repository.UpdateReleaseSignals(ctx, repository.UpdateReleaseSignalsParams{
+				IsSecurity:        releaseSignals.IsSecurity,
+				IsBreaking:        releaseSignals.IsBreaking,
+				HasDeprecation:    releaseSignals.HasDeprecation,
+				HasMigrationGuide: releaseSignals.HasMigrationGuide,
+				VulnerabilityIds:  strings.Join(releaseSignals.VulnerabilityIDs, ","),
+				ID:                release.ID,
+			})
+			if err != nil {
+				return backfilled, err
+			}
+
+			backfilled++
+		}
+	}
+}
+
+func (s *SyncService) reencryptToken(ctx context.Context, userID int32, username string) error {
+	// A sync could refresh the token at the same time, which must not be overwritten with the old one
+	s.userMutex.Lock(username)
+	defer s.userMutex.Unlock(username)
+
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Tokens that can't be decrypted are read as empty, writing that back would delete them for good if the key is only missing
+	if user.GithubToken.AccessToken == "" {
+		return errors.New("token can't be decrypted")
+	}
+
+	// Writing the token encrypts it with the primary key
+	return s.repository.UpdateUserToken(ctx, repository.UpdateUserTokenParams{
+		GithubToken: user.GithubToken,
+		ID:          user.ID,
+	})
 }
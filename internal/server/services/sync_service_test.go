@@ -0,0 +1,58 @@
+package services
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/github"
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+func TestDeletedReleases(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	stored := func(id string, createdAt time.Time, publishedAt time.Time) repository.Release {
+		return repository.Release{
+			GithubID:        id,
+			TagName:         id,
+			ReleasedAt:      publishedAt,
+			GithubCreatedAt: sql.NullTime{Time: createdAt, Valid: true},
+		}
+	}
+	fetched := func(id string, createdAt time.Time) github.Release {
+		return github.Release{ID: id, TagName: id, CreatedAt: createdAt, PublishedAt: createdAt}
+	}
+
+	ghReleases := []github.Release{fetched("v3", now.Add(-day)), fetched("v2", now.Add(-2*day))}
+
+	tests := []struct {
+		name     string
+		releases []repository.Release
+		deleted  []string
+	}{
+		{"all fetched", []repository.Release{stored("v3", now.Add(-day), now.Add(-day)), stored("v2", now.Add(-2*day), now.Add(-2*day))}, nil},
+		{"deleted within the window", []repository.Release{stored("v2.5", now.Add(-36*time.Hour), now.Add(-36*time.Hour))}, []string{"v2.5"}},
+		{"older than the window", []repository.Release{stored("v1", now.Add(-10*day), now.Add(-10*day))}, nil},
+		{"draft published after the window", []repository.Release{stored("v1.5", now.Add(-10*day), now.Add(-time.Hour))}, nil},
+		{"unknown creation time", []repository.Release{{GithubID: "v2.5", TagName: "v2.5", ReleasedAt: now.Add(-36 * time.Hour)}}, nil},
+		{"already retracted", []repository.Release{{
+			GithubID:        "v2.5",
+			TagName:         "v2.5",
+			GithubCreatedAt: sql.NullTime{Time: now.Add(-36 * time.Hour), Valid: true},
+			RetractedAt:     sql.NullTime{Time: now, Valid: true},
+		}}, nil},
+	}
+
+	for _, test := range tests {
+		var deleted []string
+		for _, release := range deletedReleases(test.releases, ghReleases) {
+			deleted = append(deleted, release.TagName)
+		}
+
+		if len(deleted) != len(test.deleted) || (len(deleted) > 0 && deleted[0] != test.deleted[0]) {
+			t.Errorf("%s: deletedReleases() = %v, want %v", test.name, deleted, test.deleted)
+		}
+	}
+}
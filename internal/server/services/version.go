@@ -0,0 +1,68 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MajorVersion extracts the major version from a release tag like "v1.2.3", "release-2.0" or "pkg@3.1.0".
+// It returns false if the tag doesn't start with a version number.
+func MajorVersion(tagName string) (int, bool) {
+	// Monorepos often prefix their tags with the package name
+	if idx := strings.LastIndex(tagName, "@"); idx >= 0 {
+		tagName = tagName[idx+1:]
+	}
+
+	start := strings.IndexFunc(tagName, isDigit)
+	if start < 0 {
+		return 0, false
+	}
+
+	// Only allow a short prefix like "v" or "release-" in front of the version
+	if start > 0 && !strings.ContainsAny(tagName[start-1:start], "vV-_/") {
+		return 0, false
+	}
+
+	end := strings.IndexFunc(tagName[start:], func(r rune) bool { return !isDigit(r) })
+	if end < 0 {
+		end = len(tagName) - start
+	}
+
+	major, err := strconv.Atoi(tagName[start : start+end])
+	if err != nil {
+		return 0, false
+	}
+
+	return major, true
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// IsPatchedVersion reports whether a release tag is one of the comma separated patched versions of an advisory,
+// e.g. tag "v1.4.2" for patched versions "1.4.2, 2.0.1"
+func IsPatchedVersion(tagName string, patchedVersions string) bool {
+	version := normalizeVersion(tagName)
+	if version == "" {
+		return false
+	}
+
+	for _, patchedVersion := range strings.Split(patchedVersions, ",") {
+		patchedVersion = strings.TrimLeft(strings.TrimSpace(patchedVersion), ">=~^ ")
+		if normalizeVersion(patchedVersion) == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeVersion strips package prefixes and a leading "v" from a tag
+func normalizeVersion(tagName string) string {
+	if idx := strings.LastIndex(tagName, "@"); idx >= 0 {
+		tagName = tagName[idx+1:]
+	}
+
+	return strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(tagName), "v"), "V")
+}
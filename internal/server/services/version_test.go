@@ -0,0 +1,51 @@
+package services
+
+import "testing"
+
+func TestMajorVersion(t *testing.T) {
+	tests := []struct {
+		tagName string
+		major   int
+		ok      bool
+	}{
+		{"v1.2.3", 1, true},
+		{"2.0.0", 2, true},
+		{"V10.0", 10, true},
+		{"release-3.4.5", 3, true},
+		{"@scope/pkg@4.1.0", 4, true},
+		{"go/v5.0.0", 5, true},
+		{"2024.01.15", 2024, true},
+		{"nightly", 0, false},
+		{"build42", 0, false},
+		{"", 0, false},
+	}
+
+	for _, test := range tests {
+		major, ok := MajorVersion(test.tagName)
+		if ok != test.ok || major != test.major {
+			t.Errorf("MajorVersion(%q) = %d, %t, want %d, %t", test.tagName, major, ok, test.major, test.ok)
+		}
+	}
+}
+
+func TestIsPatchedVersion(t *testing.T) {
+	tests := []struct {
+		tagName         string
+		patchedVersions string
+		want            bool
+	}{
+		{"v1.4.2", "1.4.2", true},
+		{"1.4.2", ">= 1.4.2", true},
+		{"v2.0.1", "1.4.2, 2.0.1", true},
+		{"pkg@3.0.0", "3.0.0", true},
+		{"v1.4.1", "1.4.2", false},
+		{"v1.4.2", "", false},
+		{"", "1.4.2", false},
+	}
+
+	for _, test := range tests {
+		if got := IsPatchedVersion(test.tagName, test.patchedVersions); got != test.want {
+			t.Errorf("IsPatchedVersion(%q, %q) = %t, want %t", test.tagName, test.patchedVersions, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/authn"
+	"github.com/benjasper/releases.one/internal/repository"
+	"github.com/benjasper/releases.one/pkg/jwtkeys"
+	"github.com/google/uuid"
+)
+
+// refreshReuseGracePeriod is how long the previous refresh token of a session stays usable after a rotation. Browsers refresh from
+// several tabs or requests at once, only the first one rotates and the others must not be mistaken for a stolen token.
+const refreshReuseGracePeriod = 30 * time.Second
+
+// createSession starts a new session, with the ID of its first refresh token
+func createSession(ctx context.Context, queries *repository.Queries, userID int32, userAgent string, ipAddress string) (sessionID string, refreshTokenID string, err error) {
+	sessionID = uuid.NewString()
+	refreshTokenID = uuid.NewString()
+
+	err = queries.CreateSession(ctx, repository.CreateSessionParams{
+		ID:             sessionID,
+		UserID:         userID,
+		RefreshTokenID: refreshTokenID,
+		UserAgent:      truncate(userAgent, 512),
+		IpAddress:      ipAddress,
+		CreatedAt:      time.Now(),
+		LastUsedAt:     time.Now(),
+		ExpiresAt:      time.Now().Add(refreshTokenLifetime),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return sessionID, refreshTokenID, nil
+}
+
+// isConcurrentRefresh reports whether a refresh token that isn't the current one of its session was only just rotated
+func isConcurrentRefresh(session *repository.Session, refreshTokenID string, now time.Time) bool {
+	return session.PreviousRefreshTokenID.Valid && session.PreviousRefreshTokenID.String == refreshTokenID &&
+		session.RotatedAt.Valid && now.Sub(session.RotatedAt.Time) < refreshReuseGracePeriod
+}
+
+// accessTokenFromRequest returns the access token of a request, from the Authorization header or the cookie
+func accessTokenFromRequest(req *http.Request) string {
+	rawToken, _ := authn.BearerToken(req)
+	if rawToken != "" {
+		return rawToken
+	}
+
+	cookies := req.CookiesNamed("access_token")
+	if len(cookies) == 0 {
+		return ""
+	}
+
+	return cookies[0].Value
+}
+
+// currentSessionID returns the session of the access token a request was authenticated with
+func currentSessionID(header http.Header, signingKeys *jwtkeys.Keyring) (string, error) {
+	_, sessionID, err := validateAccessTokenClaims(accessTokenFromRequest(&http.Request{Header: header}), signingKeys)
+	return sessionID, err
+}
+
+// validateSession checks that an access token's session hasn't been revoked or expired
+func validateSession(ctx context.Context, queries *repository.Queries, userID int, sessionID string) error {
+	session, err := queries.GetActiveSession(ctx, repository.GetActiveSessionParams{
+		ID:  sessionID,
+		Now: time.Now(),
+	})
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return errors.New("session expired or revoked")
+	} else if err != nil {
+		return err
+	}
+
+	if int(session.UserID) != userID {
+		return errors.New("session belongs to another user")
+	}
+
+	return nil
+}
+
+// authenticatedUserID returns the logged in user of a request, for endpoints outside of the API middleware
+func authenticatedUserID(ctx context.Context, queries *repository.Queries, req *http.Request, signingKeys *jwtkeys.Keyring) (int, error) {
+	userID, sessionID, err := validateAccessTokenClaims(accessTokenFromRequest(req), signingKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	err = validateSession(ctx, queries, userID, sessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// clientIP returns the address a request came from, preferring the one a proxy forwarded
+func clientIP(header http.Header, remoteAddr string) string {
+	if forwardedFor := header.Get("X-Forwarded-For"); forwardedFor != "" {
+		ip, _, _ := strings.Cut(forwardedFor, ",")
+		return truncate(strings.TrimSpace(ip), 45)
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return truncate(remoteAddr, 45)
+	}
+
+	return host
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+
+	return value[:length]
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/benjasper/releases.one/internal/repository"
+)
+
+func TestIsConcurrentRefresh(t *testing.T) {
+	now := time.Now()
+	session := &repository.Session{
+		RefreshTokenID:         "current",
+		PreviousRefreshTokenID: sql.NullString{String: "previous", Valid: true},
+		RotatedAt:              sql.NullTime{Time: now.Add(-5 * time.Second), Valid: true},
+	}
+
+	if !isConcurrentRefresh(session, "previous", now) {
+		t.Fatal("expected the previous token to be accepted right after the rotation")
+	}
+
+	if isConcurrentRefresh(session, "older", now) {
+		t.Fatal("expected an older token to be rejected")
+	}
+
+	if isConcurrentRefresh(session, "previous", now.Add(refreshReuseGracePeriod)) {
+		t.Fatal("expected the previous token to be rejected after the grace period")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	if ip := clientIP(http.Header{}, "192.0.2.1:1234"); ip != "192.0.2.1" {
+		t.Fatalf("expected 192.0.2.1, got %s", ip)
+	}
+
+	if ip := clientIP(http.Header{}, "[2001:db8::1]:1234"); ip != "2001:db8::1" {
+		t.Fatalf("expected 2001:db8::1, got %s", ip)
+	}
+
+	header := http.Header{}
+	header.Set("X-Forwarded-For", "198.51.100.7, 10.0.0.1")
+	if ip := clientIP(header, "10.0.0.1:1234"); ip != "198.51.100.7" {
+		t.Fatalf("expected 198.51.100.7, got %s", ip)
+	}
+}
@@ -0,0 +1,68 @@
+// Package signals detects noteworthy markers like security fixes and breaking changes in release notes.
+package signals
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Signals are the markers found in a single release's notes
+type Signals struct {
+	// VulnerabilityIDs are the CVE and GHSA identifiers mentioned, uppercased and deduplicated
+	VulnerabilityIDs  []string
+	IsSecurity        bool
+	IsBreaking        bool
+	HasDeprecation    bool
+	HasMigrationGuide bool
+}
+
+var (
+	cvePattern  = regexp.MustCompile(`(?i)\bCVE-\d{4}-\d{4,}\b`)
+	ghsaPattern = regexp.MustCompile(`(?i)\bGHSA(?:-[23456789cfghjmpqrvwx]{4}){3}\b`)
+
+	securityHeadingPattern = regexp.MustCompile(`(?im)^\s*#{1,6}[^\n]*\bsecurity\b`)
+	securityPattern        = regexp.MustCompile(`(?i)\bsecurity (?:fix|fixes|release|update|updates|patch|patches|advisory|advisories|issue|issues)\b|\bvulnerabilit(?:y|ies)\b`)
+
+	breakingHeadingPattern = regexp.MustCompile(`(?im)^\s*#{1,6}[^\n]*\bbreaking\b`)
+	breakingPattern        = regexp.MustCompile(`(?i)\bBREAKING[ -]CHANGES?\b`)
+	// Conventional commits mark breaking changes with a "!" before the colon, e.g. "feat(api)!: drop v1"
+	conventionalBreakingPattern = regexp.MustCompile(`(?m)^\s*(?:[-*]\s+)?\w+(?:\([^)\n]*\))?!:`)
+
+	deprecationPattern = regexp.MustCompile(`(?i)\bdeprecat(?:e|ed|es|ing|ion|ions)\b`)
+
+	migrationHeadingPattern = regexp.MustCompile(`(?im)^\s*#{1,6}[^\n]*\b(?:migrat|upgrad)\w*`)
+	migrationPattern        = regexp.MustCompile(`(?i)\b(?:migration|upgrade|upgrading) guide\b|\bmigrating (?:from|to)\b`)
+)
+
+// Detect parses release notes, given as markdown, for signals
+func Detect(notes string) Signals {
+	signals := Signals{}
+
+	for _, match := range append(cvePattern.FindAllString(notes, -1), ghsaPattern.FindAllString(notes, -1)...) {
+		id := strings.ToUpper(match)
+		// GHSA IDs are canonically lowercase after the prefix
+		if strings.HasPrefix(id, "GHSA-") {
+			id = "GHSA-" + strings.ToLower(id[len("GHSA-"):])
+		}
+
+		if !slices.Contains(signals.VulnerabilityIDs, id) {
+			signals.VulnerabilityIDs = append(signals.VulnerabilityIDs, id)
+		}
+	}
+
+	signals.IsSecurity = len(signals.VulnerabilityIDs) > 0 ||
+		securityHeadingPattern.MatchString(notes) ||
+		securityPattern.MatchString(notes)
+
+	signals.IsBreaking = breakingHeadingPattern.MatchString(notes) ||
+		breakingPattern.MatchString(notes) ||
+		conventionalBreakingPattern.MatchString(notes)
+
+	signals.HasDeprecation = deprecationPattern.MatchString(notes)
+
+	signals.HasMigrationGuide = migrationHeadingPattern.MatchString(notes) ||
+		migrationPattern.MatchString(notes)
+
+	return signals
+}
@@ -0,0 +1,89 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name  string
+		notes string
+		want  Signals
+	}{
+		{
+			name:  "plain release",
+			notes: "## Features\n- Added a new flag\n- Faster startup",
+			want:  Signals{},
+		},
+		{
+			name:  "vulnerability identifiers",
+			notes: "Fixes cve-2026-1234 and GHSA-jfh8-c2jp-5v3q, see also CVE-2026-1234",
+			want: Signals{
+				VulnerabilityIDs: []string{"CVE-2026-1234", "GHSA-jfh8-c2jp-5v3q"},
+				IsSecurity:       true,
+			},
+		},
+		{
+			name:  "security heading",
+			notes: "### Security\n- Sanitize user input",
+			want:  Signals{IsSecurity: true},
+		},
+		{
+			name:  "security fix prose",
+			notes: "This release contains an important security fix.",
+			want:  Signals{IsSecurity: true},
+		},
+		{
+			name:  "breaking change marker",
+			notes: "BREAKING CHANGE: the config file moved",
+			want:  Signals{IsBreaking: true},
+		},
+		{
+			name:  "breaking heading",
+			notes: "## ⚠ Breaking Changes\n- Dropped Node 18",
+			want:  Signals{IsBreaking: true},
+		},
+		{
+			name:  "conventional commit",
+			notes: "- feat(api)!: remove v1 endpoints\n- fix: typo",
+			want:  Signals{IsBreaking: true},
+		},
+		{
+			name:  "deprecation and migration guide",
+			notes: "The old client is deprecated, read the migration guide before upgrading.",
+			want:  Signals{HasDeprecation: true, HasMigrationGuide: true},
+		},
+		{
+			name:  "upgrade heading",
+			notes: "## Upgrading from v2\nRename your imports.",
+			want:  Signals{HasMigrationGuide: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Detect(test.notes)
+
+			if !slices.Equal(got.VulnerabilityIDs, test.want.VulnerabilityIDs) {
+				t.Errorf("VulnerabilityIDs = %v, want %v", got.VulnerabilityIDs, test.want.VulnerabilityIDs)
+			}
+
+			if got.IsSecurity != test.want.IsSecurity {
+				t.Errorf("IsSecurity = %t, want %t", got.IsSecurity, test.want.IsSecurity)
+			}
+
+			if got.IsBreaking != test.want.IsBreaking {
+				t.Errorf("IsBreaking = %t, want %t", got.IsBreaking, test.want.IsBreaking)
+			}
+
+			if got.HasDeprecation != test.want.HasDeprecation {
+				t.Errorf("HasDeprecation = %t, want %t", got.HasDeprecation, test.want.HasDeprecation)
+			}
+
+			if got.HasMigrationGuide != test.want.HasMigrationGuide {
+				t.Errorf("HasMigrationGuide = %t, want %t", got.HasMigrationGuide, test.want.HasMigrationGuide)
+			}
+		})
+	}
+}
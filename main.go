@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 	"fmt"
@@ -9,8 +10,11 @@ import (
 	"net/url"
 
 	"github.com/benjasper/releases.one/internal/config"
+	"github.com/benjasper/releases.one/internal/oidc"
 	"github.com/benjasper/releases.one/internal/repository"
 	"github.com/benjasper/releases.one/internal/server"
+	"github.com/benjasper/releases.one/pkg/envelope"
+	"github.com/benjasper/releases.one/pkg/jwtkeys"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/joho/godotenv"
 	"golang.org/x/oauth2"
@@ -50,6 +54,40 @@ func main() {
 		log.Fatalf("BASE_URL must be set and must be a valid URL: %s", err)
 	}
 
+	tokenKeyring, err := envelope.ParseKeyring(cfg.TokenEncryptionKeys)
+	if err != nil {
+		log.Fatalf("TOKEN_ENCRYPTION_KEYS must be a list of id:base64key with AES keys of 16, 24 or 32 bytes: %s", err)
+	}
+	repository.SetTokenKeyring(tokenKeyring)
+
+	var signingKeys *jwtkeys.Keyring
+	if cfg.JWTSigningKeys != "" {
+		signingKeys, err = jwtkeys.ParseKeyring(cfg.JWTSigningKeys)
+		if err != nil {
+			log.Fatalf("JWT_SIGNING_KEYS must be a list of id:algorithm:base64key: %s", err)
+		}
+
+		// The JWT secret keeps verifying the tokens it signed before the signing keys were configured, so nobody is logged out
+		if cfg.JWTSecret != "" && !signingKeys.HasKey(jwtkeys.DefaultKeyID) {
+			secretKey, err := jwtkeys.NewHMACKey(jwtkeys.DefaultKeyID, []byte(cfg.JWTSecret))
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			err = signingKeys.AddVerifyKey(secretKey)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	} else {
+		secretKey, err := jwtkeys.NewHMACKey(jwtkeys.DefaultKeyID, []byte(cfg.JWTSecret))
+		if err != nil {
+			log.Fatalf("Either JWT_SIGNING_KEYS or JWT_SECRET must be set: %s", err)
+		}
+
+		signingKeys, _ = jwtkeys.NewKeyring(secretKey)
+	}
+
 	githubCallbackURL := fmt.Sprintf("%s/api/github", baseURL.String())
 
 	oauthConfig := &oauth2.Config{
@@ -60,6 +98,14 @@ func main() {
 		Endpoint:     github.Endpoint,
 	}
 
+	var oidcProvider *oidc.Provider
+	if cfg.OIDCIssuerURL != "" {
+		oidcProvider, err = oidc.Discover(context.Background(), cfg.OIDCIssuerURL, cfg.OIDCClientID, cfg.OIDCClientSecret, fmt.Sprintf("%s/api/oidc", baseURL.String()))
+		if err != nil {
+			log.Fatalf("Error discovering OpenID Connect provider at OIDC_ISSUER_URL: %s", err)
+		}
+	}
+
 	db, err := sql.Open("mysql", cfg.DatabaseURL)
 	if err != nil {
 		log.Fatalf("Error connecting to database: %s", err)
@@ -72,6 +118,6 @@ func main() {
 
 	repository := repository.New(db)
 
-	server := server.NewServer(cfg, repository, oauthConfig, baseURL, DistFS(), indexHTML)
+	server := server.NewServer(cfg, db, repository, oauthConfig, oidcProvider, signingKeys, baseURL, DistFS(), indexHTML)
 	server.Start()
 }
@@ -0,0 +1,168 @@
+package diff
+
+import (
+	"errors"
+	"slices"
+)
+
+// Operation describes what happened to a line between the old and the new text
+type Operation int
+
+const (
+	Equal Operation = iota
+	Insert
+	Delete
+)
+
+const (
+	// MaxLines is how many lines of both texts together are diffed at most
+	MaxLines = 10000
+	// MaxEdits is how many inserted and deleted lines a diff may have at most, memory grows with its square
+	MaxEdits = 1000
+)
+
+// ErrTooLarge is returned for texts that are too large or too different to be diffed cheaply
+var ErrTooLarge = errors.New("texts are too large to diff")
+
+// Line is a single line of a diff
+type Line struct {
+	Operation Operation `json:"operation"`
+	Text      string    `json:"text"`
+}
+
+// Lines computes a line based diff between a and b with Myers' algorithm, which takes time and memory in proportion to
+// how much changed instead of how long the texts are. Deletions are listed before insertions where lines were replaced.
+// It returns ErrTooLarge for texts longer than MaxLines or with more than MaxEdits changed lines.
+func Lines(a []string, b []string) ([]Line, error) {
+	if len(a)+len(b) > MaxLines {
+		return nil, ErrTooLarge
+	}
+
+	// Lines at the start and end are usually untouched, they don't need to go through the algorithm
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	changed, err := shortestEdit(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]Line, 0, prefix+len(changed)+suffix)
+	for _, text := range a[:prefix] {
+		lines = append(lines, Line{Operation: Equal, Text: text})
+	}
+
+	lines = append(lines, changed...)
+
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, Line{Operation: Equal, Text: text})
+	}
+
+	return lines, nil
+}
+
+// shortestEdit finds the diff with the fewest inserted and deleted lines
+func shortestEdit(a []string, b []string) ([]Line, error) {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil, nil
+	}
+
+	// v[offset+k] is the furthest x reached on diagonal k = x - y, trace keeps v as it was before each step
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		if d > MaxEdits {
+			return nil, ErrTooLarge
+		}
+
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if d == 0 {
+				x = 0
+			} else if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(a, b, trace), nil
+			}
+		}
+	}
+
+	return nil, ErrTooLarge
+}
+
+// backtrack walks the trace of shortestEdit back from the end of both texts to collect the lines of the diff
+func backtrack(a []string, b []string, trace [][]int) []Line {
+	lines := make([]Line, 0, max(len(a), len(b)))
+	x, y := len(a), len(b)
+
+	for d := len(trace) - 1; d > 0; d-- {
+		previous := trace[d]
+		at := func(k int) int { return previous[k+d] }
+
+		k := x - y
+		previousK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			previousK = k + 1
+		}
+
+		previousX := at(previousK)
+		previousY := previousX - previousK
+
+		for x > previousX && y > previousY {
+			lines = append(lines, Line{Operation: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+
+		if x == previousX {
+			lines = append(lines, Line{Operation: Insert, Text: b[y-1]})
+			y--
+		} else {
+			lines = append(lines, Line{Operation: Delete, Text: a[x-1]})
+			x--
+		}
+	}
+
+	for x > 0 {
+		lines = append(lines, Line{Operation: Equal, Text: a[x-1]})
+		x--
+	}
+
+	slices.Reverse(lines)
+	return lines
+}
+
+// HasChanges reports whether a diff contains any inserted or deleted lines
+func HasChanges(lines []Line) bool {
+	for _, line := range lines {
+		if line.Operation != Equal {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want []Line
+	}{
+		{
+			name: "identical",
+			a:    []string{"one", "two"},
+			b:    []string{"one", "two"},
+			want: []Line{{Equal, "one"}, {Equal, "two"}},
+		},
+		{
+			name: "appended section",
+			a:    []string{"Features", "- fast"},
+			b:    []string{"Features", "- fast", "Known issues", "- crashes on arm64"},
+			want: []Line{{Equal, "Features"}, {Equal, "- fast"}, {Insert, "Known issues"}, {Insert, "- crashes on arm64"}},
+		},
+		{
+			name: "replaced line",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "x", "c"},
+			want: []Line{{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"}},
+		},
+		{
+			name: "removed everything",
+			a:    []string{"a", "b"},
+			b:    nil,
+			want: []Line{{Delete, "a"}, {Delete, "b"}},
+		},
+		{
+			name: "moved line",
+			a:    []string{"a", "b", "c", "d"},
+			b:    []string{"b", "c", "a", "d"},
+			want: []Line{{Delete, "a"}, {Equal, "b"}, {Equal, "c"}, {Insert, "a"}, {Equal, "d"}},
+		},
+		{
+			name: "interleaved changes",
+			a:    []string{"a", "b", "c", "d", "e"},
+			b:    []string{"x", "b", "y", "d", "z"},
+			want: []Line{{Delete, "a"}, {Insert, "x"}, {Equal, "b"}, {Delete, "c"}, {Insert, "y"}, {Equal, "d"}, {Delete, "e"}, {Insert, "z"}},
+		},
+		{
+			name: "empty",
+			a:    nil,
+			b:    nil,
+			want: []Line{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Lines(test.a, test.b)
+			if err != nil {
+				t.Fatalf("Lines(%v, %v) returned error: %s", test.a, test.b, err)
+			}
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Lines(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+			}
+
+			if HasChanges(got) != (test.name != "identical" && test.name != "empty") {
+				t.Errorf("HasChanges(%v) = %t", got, HasChanges(got))
+			}
+		})
+	}
+}
+
+func TestLinesTooLarge(t *testing.T) {
+	long := make([]string, MaxLines)
+	if _, err := Lines(long, long[:1]); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Lines() with %d lines returned %v, want ErrTooLarge", MaxLines+1, err)
+	}
+
+	// Completely different texts need an edit per line
+	a := make([]string, MaxEdits)
+	b := make([]string, MaxEdits)
+	for i := range a {
+		a[i] = fmt.Sprintf("a%d", i)
+		b[i] = fmt.Sprintf("b%d", i)
+	}
+	if _, err := Lines(a, b); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Lines() with %d edits returned %v, want ErrTooLarge", 2*MaxEdits, err)
+	}
+
+	// Long texts with few changes are cheap
+	b = slices.Clone(a)
+	b[MaxEdits/2] = "changed"
+	lines, err := Lines(a, b)
+	if err != nil {
+		t.Fatalf("Lines() with a single change returned error: %s", err)
+	}
+	if len(lines) != MaxEdits+1 {
+		t.Errorf("Lines() with a single change returned %d lines, want %d", len(lines), MaxEdits+1)
+	}
+}
@@ -0,0 +1,123 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Envelope is an encrypted value together with the ID of the key it was encrypted with
+type Envelope struct {
+	KeyID string `json:"key_id"`
+	// Nonce followed by the AES-GCM sealed data
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Keyring encrypts with its primary key and decrypts with any of its keys, so keys can be rotated without downtime
+type Keyring struct {
+	primaryKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring from AES keys of 16, 24 or 32 bytes by ID, primaryKeyID is the one used for encryption
+func NewKeyring(primaryKeyID string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primaryKeyID]; !ok {
+		return nil, fmt.Errorf("primary key %q is not part of the keyring", primaryKeyID)
+	}
+
+	keyring := &Keyring{
+		primaryKeyID: primaryKeyID,
+		keys:         make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for id, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("invalid key %q", id))
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("invalid key %q", id))
+		}
+
+		keyring.keys[id] = aead
+	}
+
+	return keyring, nil
+}
+
+// ParseKeyring parses keys in the form "id:base64key,id:base64key", the first key is the primary key
+func ParseKeyring(spec string) (*Keyring, error) {
+	primaryKeyID := ""
+	keys := make(map[string][]byte)
+
+	for _, entry := range strings.Split(spec, ",") {
+		id, encodedKey, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found || id == "" {
+			return nil, fmt.Errorf("key %q must be in the form id:base64key", entry)
+		}
+
+		if _, ok := keys[id]; ok {
+			return nil, fmt.Errorf("key %q is defined more than once", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("key %q is not valid base64", id))
+		}
+
+		if primaryKeyID == "" {
+			primaryKeyID = id
+		}
+
+		keys[id] = key
+	}
+
+	return NewKeyring(primaryKeyID, keys)
+}
+
+// PrimaryKeyID returns the ID of the key new envelopes are encrypted with
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryKeyID
+}
+
+// Encrypt seals plaintext with the primary key
+func (k *Keyring) Encrypt(plaintext []byte) (*Envelope, error) {
+	aead := k.keys[k.primaryKeyID]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	// The key ID is authenticated as well, so an envelope can't be relabeled to another key
+	return &Envelope{
+		KeyID:      k.primaryKeyID,
+		Ciphertext: aead.Seal(nonce, nonce, plaintext, []byte(k.primaryKeyID)),
+	}, nil
+}
+
+// Decrypt opens an envelope with the key it was encrypted with
+func (k *Keyring) Decrypt(envelope *Envelope) ([]byte, error) {
+	aead, ok := k.keys[envelope.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", envelope.KeyID)
+	}
+
+	if len(envelope.Ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := envelope.Ciphertext[:aead.NonceSize()], envelope.Ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(envelope.KeyID))
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed to decrypt with key %q", envelope.KeyID))
+	}
+
+	return plaintext, nil
+}
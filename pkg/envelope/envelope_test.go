@@ -0,0 +1,128 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32))
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	keyring, err := ParseKeyring("1:" + testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope, err := keyring.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if envelope.KeyID != "1" {
+		t.Errorf("expected key ID 1, got %q", envelope.KeyID)
+	}
+
+	if bytes.Contains(envelope.Ciphertext, []byte("secret")) {
+		t.Error("ciphertext contains the plaintext")
+	}
+
+	plaintext, err := keyring.Decrypt(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(plaintext) != "secret" {
+		t.Errorf("expected secret, got %q", plaintext)
+	}
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	oldKeyring, err := ParseKeyring("1:" + testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope, err := oldKeyring.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The new key comes first, the old one stays around for decryption
+	keyring, err := ParseKeyring("2:" + testKey(2) + ",1:" + testKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if keyring.PrimaryKeyID() != "2" {
+		t.Errorf("expected primary key ID 2, got %q", keyring.PrimaryKeyID())
+	}
+
+	plaintext, err := keyring.Decrypt(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(plaintext) != "secret" {
+		t.Errorf("expected secret, got %q", plaintext)
+	}
+
+	reencrypted, err := keyring.Encrypt(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reencrypted.KeyID != "2" {
+		t.Errorf("expected key ID 2, got %q", reencrypted.KeyID)
+	}
+
+	if _, err := oldKeyring.Decrypt(reencrypted); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+}
+
+func TestKeyring_Tampering(t *testing.T) {
+	keyring, err := ParseKeyring("1:" + testKey(1) + ",2:" + testKey(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope, err := keyring.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envelope.Ciphertext[len(envelope.Ciphertext)-1] ^= 1
+	if _, err := keyring.Decrypt(envelope); err == nil {
+		t.Error("expected an error for a modified ciphertext")
+	}
+	envelope.Ciphertext[len(envelope.Ciphertext)-1] ^= 1
+
+	envelope.KeyID = "2"
+	if _, err := keyring.Decrypt(envelope); err == nil {
+		t.Error("expected an error for a relabeled envelope")
+	}
+
+	if _, err := keyring.Decrypt(&Envelope{KeyID: "1", Ciphertext: []byte{1}}); err == nil {
+		t.Error("expected an error for a truncated ciphertext")
+	}
+}
+
+func TestParseKeyring_Invalid(t *testing.T) {
+	specs := []string{
+		"",
+		"1",
+		":" + testKey(1),
+		"1:not-base64!",
+		"1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"1:" + testKey(1) + ",1:" + testKey(2),
+	}
+
+	for _, spec := range specs {
+		if _, err := ParseKeyring(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package jwtkeys
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is a JWT signing key identified by the kid header
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	signingKey any
+	verifyKey  any
+}
+
+// NewHMACKey creates a symmetric HS256 key, which is never published
+func NewHMACKey(id string, secret []byte) (*Key, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("key %q has an empty secret", id)
+	}
+
+	return &Key{ID: id, Method: jwt.SigningMethodHS256, signingKey: secret, verifyKey: secret}, nil
+}
+
+// NewEdDSAKey creates an Ed25519 key
+func NewEdDSAKey(id string, privateKey ed25519.PrivateKey) *Key {
+	return &Key{ID: id, Method: jwt.SigningMethodEdDSA, signingKey: privateKey, verifyKey: privateKey.Public()}
+}
+
+// NewES256Key creates an ECDSA key on the P-256 curve
+func NewES256Key(id string, privateKey *ecdsa.PrivateKey) (*Key, error) {
+	if privateKey.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("key %q must use the P-256 curve", id)
+	}
+
+	return &Key{ID: id, Method: jwt.SigningMethodES256, signingKey: privateKey, verifyKey: &privateKey.PublicKey}, nil
+}
+
+// DefaultKeyID is the ID of the key tokens without a key ID are verified with. They were issued before keys had IDs,
+// signed with the JWT secret.
+const DefaultKeyID = "default"
+
+// Keyring signs with its primary key and verifies with all of its keys, so keys can be rotated with an overlap window
+type Keyring struct {
+	primary *Key
+	keys    map[string]*Key
+}
+
+// NewKeyring creates a keyring, the first key is the primary key
+func NewKeyring(keys ...*Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	keyring := &Keyring{primary: keys[0], keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("keys need an ID")
+		}
+
+		if _, ok := keyring.keys[key.ID]; ok {
+			return nil, fmt.Errorf("key %q is defined more than once", key.ID)
+		}
+
+		keyring.keys[key.ID] = key
+	}
+
+	return keyring, nil
+}
+
+// AddVerifyKey adds a key that only verifies tokens and never signs
+func (k *Keyring) AddVerifyKey(key *Key) error {
+	if key.ID == "" {
+		return errors.New("keys need an ID")
+	}
+
+	if _, ok := k.keys[key.ID]; ok {
+		return fmt.Errorf("key %q is defined more than once", key.ID)
+	}
+
+	k.keys[key.ID] = key
+	return nil
+}
+
+// HasKey reports whether the keyring has a key with the ID
+func (k *Keyring) HasKey(id string) bool {
+	_, ok := k.keys[id]
+	return ok
+}
+
+// ParseKeyring parses keys in the form "id:algorithm:base64key,...", the first key is the primary key.
+// HS256 keys are the secret itself, EdDSA and ES256 keys are PKCS #8 private keys in DER form.
+func ParseKeyring(spec string) (*Keyring, error) {
+	keys := []*Key{}
+
+	for _, entry := range strings.Split(spec, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("key %q must be in the form id:algorithm:base64key", entry)
+		}
+		id, algorithm, encodedKey := parts[0], parts[1], parts[2]
+
+		keyBytes, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("key %q is not valid base64", id))
+		}
+
+		key, err := parseKey(id, algorithm, keyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return NewKeyring(keys...)
+}
+
+func parseKey(id string, algorithm string, keyBytes []byte) (*Key, error) {
+	if algorithm == jwt.SigningMethodHS256.Alg() {
+		return NewHMACKey(id, keyBytes)
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(keyBytes)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("key %q is not a PKCS #8 private key", id))
+	}
+
+	switch algorithm {
+	case jwt.SigningMethodEdDSA.Alg():
+		ed25519Key, ok := privateKey.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("key %q is not an Ed25519 key", id)
+		}
+
+		return NewEdDSAKey(id, ed25519Key), nil
+	case jwt.SigningMethodES256.Alg():
+		ecdsaKey, ok := privateKey.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("key %q is not an ECDSA key", id)
+		}
+
+		return NewES256Key(id, ecdsaKey)
+	default:
+		return nil, fmt.Errorf("key %q uses the unsupported algorithm %s", id, algorithm)
+	}
+}
+
+// Sign signs claims with the primary key
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.primary.Method, claims)
+	token.Header["kid"] = k.primary.ID
+
+	return token.SignedString(k.primary.signingKey)
+}
+
+// Keyfunc finds the key a token was signed with, for use with jwt.Parse. Tokens without a key ID are verified with
+// the key DefaultKeyID.
+func (k *Keyring) Keyfunc(token *jwt.Token) (any, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		kid = DefaultKeyID
+	}
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	// The algorithm of a key is fixed, a token can't pick another one
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+
+	return key.verifyKey, nil
+}
+
+// JSONWebKey is the public part of a key, as served in a JWKS document
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet is a JWKS document
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys of the keyring, symmetric keys are left out because they are secret
+func (k *Keyring) JWKS() JSONWebKeySet {
+	keySet := JSONWebKeySet{Keys: []JSONWebKey{}}
+
+	for _, key := range k.orderedKeys() {
+		switch publicKey := key.verifyKey.(type) {
+		case ed25519.PublicKey:
+			keySet.Keys = append(keySet.Keys, JSONWebKey{
+				KeyType:   "OKP",
+				KeyID:     key.ID,
+				Algorithm: key.Method.Alg(),
+				Use:       "sig",
+				Curve:     "Ed25519",
+				X:         base64.RawURLEncoding.EncodeToString(publicKey),
+			})
+		case *ecdsa.PublicKey:
+			// Coordinates are padded to the size of the curve
+			bytes := make([]byte, 64)
+			publicKey.X.FillBytes(bytes[:32])
+			publicKey.Y.FillBytes(bytes[32:])
+
+			keySet.Keys = append(keySet.Keys, JSONWebKey{
+				KeyType:   "EC",
+				KeyID:     key.ID,
+				Algorithm: key.Method.Alg(),
+				Use:       "sig",
+				Curve:     "P-256",
+				X:         base64.RawURLEncoding.EncodeToString(bytes[:32]),
+				Y:         base64.RawURLEncoding.EncodeToString(bytes[32:]),
+			})
+		}
+	}
+
+	return keySet
+}
+
+// orderedKeys returns the primary key first, followed by the other keys ordered by ID
+func (k *Keyring) orderedKeys() []*Key {
+	keys := []*Key{k.primary}
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		if id != k.primary.ID {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+	for _, id := range ids {
+		keys = append(keys, k.keys[id])
+	}
+
+	return keys
+}
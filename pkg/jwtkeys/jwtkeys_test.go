@@ -0,0 +1,240 @@
+package jwtkeys
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func testKeys(t *testing.T) (hmacKey *Key, ed25519Key *Key, ecdsaKey *Key) {
+	hmacKey, err := NewHMACKey("hmac", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ed25519PrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecdsaPrivateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecdsaKey, err = NewES256Key("ecdsa", ecdsaPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return hmacKey, NewEdDSAKey("ed25519", ed25519PrivateKey), ecdsaKey
+}
+
+func TestKeyring_SignAndVerify(t *testing.T) {
+	hmacKey, ed25519Key, ecdsaKey := testKeys(t)
+
+	for _, key := range []*Key{hmacKey, ed25519Key, ecdsaKey} {
+		keyring, err := NewKeyring(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		signed, err := keyring.Sign(jwt.RegisteredClaims{Subject: "1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		token, err := jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, keyring.Keyfunc)
+		if err != nil {
+			t.Fatalf("%s: %s", key.ID, err)
+		}
+
+		if token.Header["kid"] != key.ID || token.Method.Alg() != key.Method.Alg() {
+			t.Fatalf("%s: unexpected header %v", key.ID, token.Header)
+		}
+	}
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	hmacKey, ed25519Key, _ := testKeys(t)
+
+	oldKeyring, err := NewKeyring(hmacKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := oldKeyring.Sign(jwt.RegisteredClaims{Subject: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// During the overlap the new key signs and the old one still verifies
+	keyring, err := NewKeyring(ed25519Key, hmacKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(signed, keyring.Keyfunc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Once the old key is gone, its tokens stop working
+	newKeyring, err := NewKeyring(ed25519Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(signed, newKeyring.Keyfunc); err == nil {
+		t.Fatal("expected error for a token of a removed key")
+	}
+}
+
+func TestKeyring_RejectsOtherAlgorithms(t *testing.T) {
+	_, ed25519Key, _ := testKeys(t)
+
+	keyring, err := NewKeyring(ed25519Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A token that claims the key ID of an asymmetric key but is signed with HMAC must not verify
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "1"})
+	token.Header["kid"] = ed25519Key.ID
+	signed, err := token.SignedString([]byte(ed25519Key.verifyKey.(ed25519.PublicKey)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(signed, keyring.Keyfunc); err == nil {
+		t.Fatal("expected error for a token with another algorithm")
+	}
+
+	withoutKeyID, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.RegisteredClaims{}).SignedString(ed25519Key.signingKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(withoutKeyID, keyring.Keyfunc); err == nil {
+		t.Fatal("expected error for a token without key ID")
+	}
+}
+
+func TestKeyring_DefaultKey(t *testing.T) {
+	_, ed25519Key, _ := testKeys(t)
+
+	secretKey, err := NewHMACKey(DefaultKeyID, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tokens signed with the JWT secret before keys had IDs
+	withoutKeyID, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "1"}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyring, err := NewKeyring(ed25519Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(withoutKeyID, keyring.Keyfunc); err == nil {
+		t.Fatal("expected error for a token without key ID and no default key")
+	}
+
+	err = keyring.AddVerifyKey(secretKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := jwt.Parse(withoutKeyID, keyring.Keyfunc); err != nil {
+		t.Fatal(err)
+	}
+
+	// A verify key never signs
+	signed, err := keyring.Sign(jwt.RegisteredClaims{Subject: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.Parse(signed, keyring.Keyfunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token.Header["kid"] != ed25519Key.ID {
+		t.Fatalf("unexpected key %v", token.Header["kid"])
+	}
+
+	if err := keyring.AddVerifyKey(secretKey); err == nil {
+		t.Fatal("expected error for a key that is defined more than once")
+	}
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	hmacKey, ed25519Key, ecdsaKey := testKeys(t)
+
+	keyring, err := NewKeyring(hmacKey, ed25519Key, ecdsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keySet := keyring.JWKS()
+	if len(keySet.Keys) != 2 {
+		t.Fatalf("expected only the 2 public keys, got %d", len(keySet.Keys))
+	}
+
+	for _, key := range keySet.Keys {
+		if key.KeyID == hmacKey.ID {
+			t.Fatal("hmac key must never be published")
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil || len(x) != 32 {
+			t.Fatalf("%s: unexpected x coordinate %q", key.KeyID, key.X)
+		}
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	_, ed25519PrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(ed25519PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := "2025:EdDSA:" + base64.StdEncoding.EncodeToString(der) + ",2024:HS256:" + base64.StdEncoding.EncodeToString([]byte("secret"))
+	keyring, err := ParseKeyring(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if keyring.primary.ID != "2025" || keyring.primary.Method != jwt.SigningMethodEdDSA {
+		t.Fatalf("unexpected primary key %s", keyring.primary.ID)
+	}
+
+	invalid := []string{
+		"",
+		"1:HS256",
+		"1:RS256:" + base64.StdEncoding.EncodeToString(der),
+		"1:ES256:" + base64.StdEncoding.EncodeToString(der),
+		"1:EdDSA:" + base64.StdEncoding.EncodeToString([]byte("not a key")),
+		"1:HS256:c2VjcmV0,1:HS256:c2VjcmV0",
+	}
+
+	for _, spec := range invalid {
+		if _, err := ParseKeyring(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
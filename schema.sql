@@ -7,12 +7,17 @@ CREATE TABLE `repositories` (
   `private` bool NOT NULL,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
-  `last_synced_at` datetime NOT NULL,
+  `last_synced_at` datetime NULL,
+  `advisories_synced_at` datetime NULL,
   `image_url` varchar(255) NOT NULL,
   `image_size` int NOT NULL,
   `hash` bigint unsigned NOT NULL,
+  `orphaned_at` datetime NULL,
+  `next_sync_at` datetime NULL,
   PRIMARY KEY (`id`),
-  UNIQUE INDEX `github_id` (`github_id`)
+  UNIQUE INDEX `github_id` (`github_id`),
+  INDEX `orphaned_at` (`orphaned_at`),
+  INDEX `next_sync_at` (`next_sync_at`)
 );
 
 -- Create "releases" table
@@ -25,14 +30,30 @@ CREATE TABLE `releases` (
   `tag_name` varchar(255) NOT NULL,
   `description` longtext NOT NULL,
   `description_short` text NOT NULL,
+  `description_text` longtext NOT NULL,
   `author` varchar(255) NULL,
   `is_prerelease` bool NOT NULL,
+  `is_security` bool NOT NULL,
+  `is_breaking` bool NOT NULL,
+  `has_deprecation` bool NOT NULL,
+  `has_migration_guide` bool NOT NULL,
+  `vulnerability_ids` text NOT NULL,
+  `signals_detected` bool NOT NULL DEFAULT false,
+  `major_version` int NULL,
   `released_at` datetime NOT NULL,
+  `github_created_at` datetime NULL,
+  `first_seen_at` datetime NULL,
+  `is_backfill` bool NOT NULL DEFAULT false,
+  `edited_at` datetime NULL,
+  `retracted_at` datetime NULL,
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
   `hash` bigint unsigned NOT NULL,
   PRIMARY KEY (`id`),
   INDEX `repository_id` (`repository_id`),
+  INDEX `signals_detected` (`signals_detected`),
+  INDEX `first_seen_at` (`first_seen_at`),
+  FULLTEXT INDEX `search` (`name`, `tag_name`, `description_text`),
   CONSTRAINT `releases_ibfk_1` FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
 );
 
@@ -40,15 +61,22 @@ CREATE TABLE `releases` (
 CREATE TABLE `users` (
   `id` int NOT NULL AUTO_INCREMENT,
   `username` varchar(255) NOT NULL,
-  `github_id` bigint unsigned NOT NULL,
-  `github_token` json NOT NULL,
+  `github_id` bigint unsigned NULL,
+  `github_token` json NULL,
   `last_synced_at` datetime NOT NULL,
   `public_id` varchar(255) NOT NULL,
   `is_onboarded` bool NOT NULL,
   `is_public` bool NOT NULL,
+  `read_all_at` datetime NULL,
+  `private_feed_id` varchar(255) NULL,
+  `release_fetch_depth` int NULL,
+  `release_retention` int NULL,
+  `github_scopes` varchar(255) NOT NULL,
+  `sync_private` bool NOT NULL,
   PRIMARY KEY (`id`),
   UNIQUE INDEX `github_id` (`github_id`),
-  INDEX `public_id` (`public_id`)
+  INDEX `public_id` (`public_id`),
+  UNIQUE INDEX `private_feed_id` (`private_feed_id`)
 );
 
 -- Create "repository_stars" table
@@ -58,8 +86,191 @@ CREATE TABLE `repository_stars` (
   `created_at` datetime NOT NULL,
   `updated_at` datetime NOT NULL,
   `type` tinyint NOT NULL,
+  `is_muted` bool NOT NULL,
+  `snoozed_until` datetime NULL,
+  `snoozed_until_major` int NULL,
   PRIMARY KEY (`repository_id`, `user_id`),
   INDEX `user_id` (`user_id`),
   CONSTRAINT `repository_stars_ibfk_1` FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
   CONSTRAINT `repository_stars_ibfk_2` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
 );
+
+-- Create "release_reads" table
+CREATE TABLE `release_reads` (
+  `user_id` int NOT NULL,
+  `release_id` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`user_id`, `release_id`),
+  INDEX `release_id` (`release_id`),
+  CONSTRAINT `release_reads_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `release_reads_ibfk_2` FOREIGN KEY (`release_id`) REFERENCES `releases` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "bookmarks" table
+CREATE TABLE `bookmarks` (
+  `user_id` int NOT NULL,
+  `release_id` int NOT NULL,
+  `note` text NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`user_id`, `release_id`),
+  INDEX `release_id` (`release_id`),
+  CONSTRAINT `bookmarks_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `bookmarks_ibfk_2` FOREIGN KEY (`release_id`) REFERENCES `releases` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "repository_groups" table
+CREATE TABLE `repository_groups` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `user_id_name` (`user_id`, `name`),
+  CONSTRAINT `repository_groups_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "repository_group_members" table
+CREATE TABLE `repository_group_members` (
+  `group_id` int NOT NULL,
+  `repository_id` int NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`group_id`, `repository_id`),
+  INDEX `repository_id` (`repository_id`),
+  CONSTRAINT `repository_group_members_ibfk_1` FOREIGN KEY (`group_id`) REFERENCES `repository_groups` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `repository_group_members_ibfk_2` FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "star_lists" table
+CREATE TABLE `star_lists` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `github_id` varchar(255) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `slug` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `github_id` (`github_id`),
+  INDEX `user_id_slug` (`user_id`, `slug`),
+  CONSTRAINT `star_lists_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "star_list_repositories" table
+CREATE TABLE `star_list_repositories` (
+  `list_id` int NOT NULL,
+  `repository_id` int NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`list_id`, `repository_id`),
+  INDEX `repository_id` (`repository_id`),
+  CONSTRAINT `star_list_repositories_ibfk_1` FOREIGN KEY (`list_id`) REFERENCES `star_lists` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `star_list_repositories_ibfk_2` FOREIGN KEY (`repository_id`) REFERENCES `repositories` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "release_advisories" table
+CREATE TABLE `release_advisories` (
+  `release_id` int NOT NULL,
+  `ghsa_id` varchar(255) NOT NULL,
+  `cve_id` varchar(255) NULL,
+  `severity` varchar(32) NOT NULL,
+  `summary` text NOT NULL,
+  `url` varchar(255) NOT NULL,
+  `published_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`release_id`, `ghsa_id`),
+  CONSTRAINT `release_advisories_ibfk_1` FOREIGN KEY (`release_id`) REFERENCES `releases` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "release_assets" table
+CREATE TABLE `release_assets` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `release_id` int NOT NULL,
+  `github_id` varchar(255) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `content_type` varchar(255) NOT NULL,
+  `size` bigint NOT NULL,
+  `download_count` int NOT NULL,
+  `url` varchar(1024) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `github_id` (`github_id`),
+  INDEX `release_id` (`release_id`),
+  CONSTRAINT `release_assets_ibfk_1` FOREIGN KEY (`release_id`) REFERENCES `releases` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "release_edits" table
+CREATE TABLE `release_edits` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `release_id` int NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `tag_name` varchar(255) NOT NULL,
+  `description` longtext NOT NULL,
+  `is_prerelease` bool NOT NULL,
+  `edited_at` datetime NOT NULL,
+  `diff` json NULL,
+  PRIMARY KEY (`id`),
+  INDEX `release_id` (`release_id`),
+  CONSTRAINT `release_edits_ibfk_1` FOREIGN KEY (`release_id`) REFERENCES `releases` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "sessions" table
+CREATE TABLE `sessions` (
+  `id` varchar(36) NOT NULL,
+  `user_id` int NOT NULL,
+  `refresh_token_id` varchar(36) NOT NULL,
+  `previous_refresh_token_id` varchar(36) NULL,
+  `rotated_at` datetime NULL,
+  `user_agent` varchar(512) NOT NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `last_used_at` datetime NOT NULL,
+  `expires_at` datetime NOT NULL,
+  `revoked_at` datetime NULL,
+  PRIMARY KEY (`id`),
+  INDEX `user_id` (`user_id`),
+  CONSTRAINT `sessions_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "api_tokens" table
+CREATE TABLE `api_tokens` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `token_hash` char(64) NOT NULL,
+  `token_prefix` varchar(16) NOT NULL,
+  `scopes` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `last_used_at` datetime NULL,
+  `expires_at` datetime NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `user_id` (`user_id`),
+  CONSTRAINT `api_tokens_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "user_identities" table
+CREATE TABLE `user_identities` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `issuer` varchar(255) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `email` varchar(255) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `last_login_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `issuer_subject` (`issuer`, `subject`),
+  INDEX `user_id` (`user_id`),
+  CONSTRAINT `user_identities_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Create "followed_repositories" view
+CREATE VIEW `followed_repositories` AS
+SELECT `repository_id` FROM `repository_stars`
+UNION
+SELECT `repository_id` FROM `repository_group_members`
+UNION
+SELECT `repository_id` FROM `star_list_repositories`
+UNION
+SELECT `releases`.`repository_id` FROM `bookmarks` INNER JOIN `releases` ON `bookmarks`.`release_id` = `releases`.`id`;